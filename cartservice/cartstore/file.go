@@ -0,0 +1,219 @@
+package cartstore
+
+import (
+	"context"
+	"fmt"
+	"recordlog"
+	"sync"
+	"time"
+
+	pb "cartservice/proto"
+)
+
+// Log operations
+const (
+	opAdd    = "add"
+	opEmpty  = "empty"
+	opUpdate = "update"
+	opRemove = "remove"
+	opEvict  = "evict"
+	opMerge  = "merge"
+	// heads a compacted log with the last version handed out, as the
+	// records of dropped carts are gone
+	opSeq = "seq"
+)
+
+// A single write-ahead log record.
+type logRecord struct {
+	Op        string    `json:"op"`
+	UserID    string    `json:"user"`
+	FromID    string    `json:"from,omitempty"`
+	ProductID string    `json:"product,omitempty"`
+	SKU       string    `json:"sku,omitempty"`
+	Quantity  int32     `json:"qty,omitempty"`
+	Version   int64     `json:"version"`
+	At        time.Time `json:"at"`
+}
+
+// Carts are kept in memory and every change is appended to a record log and
+// fsynced before it is applied, so the carts survive a restart or a crash.
+type fileCartStore struct {
+	sync.Mutex
+	log *recordlog.Log[logRecord]
+	mem *memoryCartStore
+}
+
+// Instantiate a CartStore persisted in the log file at path
+func NewFileCartStore(path string, opts ...Option) (CartStore, error) {
+	o := newOptions(opts)
+	s := &fileCartStore{mem: newMemoryCartStore(o)}
+	l, err := recordlog.Open(path, recordlog.Config[logRecord]{
+		Name:             "cart log",
+		Codec:            recordlog.JSON[logRecord](),
+		Apply:            s.apply,
+		Snapshot:         s.snapshot,
+		CompactThreshold: o.compactThreshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log = l
+	return s, nil
+}
+
+// Add Item
+func (s *fileCartStore) AddItem(ctx context.Context, userID, productID, variantSKU string, quantity int32) (*pb.AddItemResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.mem.RLock()
+	err := s.mem.checkQuantity(userID, lineKey{productID, variantSKU}, quantity)
+	s.mem.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.write(logRecord{Op: opAdd, UserID: userID, ProductID: productID, SKU: variantSKU, Quantity: quantity}); err != nil {
+		return nil, err
+	}
+	return &pb.AddItemResponse{Version: s.mem.seq}, nil
+}
+
+// Empty Cart
+func (s *fileCartStore) EmptyCart(ctx context.Context, userID string, expectedVersion *int64) (*pb.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.mem.RLock()
+	err := s.mem.checkVersion(userID, expectedVersion)
+	s.mem.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.write(logRecord{Op: opEmpty, UserID: userID}); err != nil {
+		return nil, err
+	}
+	return new(pb.Empty), nil
+}
+
+// Update Item Quantity
+func (s *fileCartStore) UpdateItemQuantity(ctx context.Context, userID, productID, variantSKU string, quantity int32) (*pb.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !s.hasItem(userID, lineKey{productID, variantSKU}) {
+		return nil, ErrItemNotFound
+	}
+	if err := s.write(logRecord{Op: opUpdate, UserID: userID, ProductID: productID, SKU: variantSKU, Quantity: quantity}); err != nil {
+		return nil, err
+	}
+	return new(pb.Empty), nil
+}
+
+// Remove Item
+func (s *fileCartStore) RemoveItem(ctx context.Context, userID, productID, variantSKU string) (*pb.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	if !s.hasItem(userID, lineKey{productID, variantSKU}) {
+		return nil, ErrItemNotFound
+	}
+	if err := s.write(logRecord{Op: opRemove, UserID: userID, ProductID: productID, SKU: variantSKU}); err != nil {
+		return nil, err
+	}
+	return new(pb.Empty), nil
+}
+
+// Merge Carts
+func (s *fileCartStore) MergeCarts(ctx context.Context, fromUserID, toUserID string, maxPerLine int32) (*pb.Cart, error) {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.write(logRecord{Op: opMerge, UserID: toUserID, FromID: fromUserID, Quantity: maxPerLine}); err != nil {
+		return nil, err
+	}
+	s.mem.RLock()
+	defer s.mem.RUnlock()
+	return s.mem.cart(toUserID), nil
+}
+
+// Get Cart
+func (s *fileCartStore) GetCart(ctx context.Context, userID string) (*pb.Cart, error) {
+	return s.mem.GetCart(ctx, userID)
+}
+
+// Evict Idle Carts
+func (s *fileCartStore) EvictIdle(ctx context.Context, idleSince time.Time) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.mem.RLock()
+	idle := s.mem.idleCarts(idleSince)
+	s.mem.RUnlock()
+
+	// carts touched by reads only have that time in memory, so log each eviction explicitly
+	for i, userID := range idle {
+		if err := s.write(logRecord{Op: opEvict, UserID: userID}); err != nil {
+			return i, err
+		}
+	}
+	return len(idle), nil
+}
+
+// Close the log file
+func (s *fileCartStore) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.log.Close()
+}
+
+// apply a record to the in-memory carts
+func (s *fileCartStore) apply(rec logRecord) error {
+	s.mem.Lock()
+	defer s.mem.Unlock()
+	// every record used up its version, whether or not a cart still carries it
+	s.mem.seq = max(s.mem.seq, rec.Version)
+	switch rec.Op {
+	case opSeq:
+	case opAdd:
+		s.mem.addItem(rec.UserID, lineKey{rec.ProductID, rec.SKU}, rec.Quantity, rec.At, rec.Version)
+	case opEmpty, opEvict:
+		s.mem.emptyCart(rec.UserID)
+	case opUpdate:
+		s.mem.updateItemQuantity(rec.UserID, lineKey{rec.ProductID, rec.SKU}, rec.Quantity, rec.At, rec.Version)
+	case opRemove:
+		s.mem.removeItem(rec.UserID, lineKey{rec.ProductID, rec.SKU}, rec.At, rec.Version)
+	case opMerge:
+		s.mem.mergeCarts(rec.FromID, rec.UserID, rec.Quantity, rec.At, rec.Version)
+	default:
+		return fmt.Errorf("unknown cart log operation %q", rec.Op)
+	}
+	return nil
+}
+
+// check that a cart line exists, so that only valid changes reach the log
+func (s *fileCartStore) hasItem(userID string, key lineKey) bool {
+	s.mem.RLock()
+	defer s.mem.RUnlock()
+	return s.mem.hasItem(userID, key)
+}
+
+// stamp a record with the next version and the current time, then append
+// it to the log, which applies it in memory
+func (s *fileCartStore) write(rec logRecord) error {
+	s.mem.RLock()
+	rec.Version = s.mem.seq + 1
+	s.mem.RUnlock()
+	rec.At = s.mem.now()
+	return s.log.Append(rec)
+}
+
+// emit the last version handed out, then one record per cart line, to compact the log
+func (s *fileCartStore) snapshot(emit func(logRecord) error) error {
+	s.mem.RLock()
+	defer s.mem.RUnlock()
+	if err := emit(logRecord{Op: opSeq, Version: s.mem.seq, At: s.mem.now()}); err != nil {
+		return err
+	}
+	for userID, cart := range s.mem.carts {
+		for key, quantity := range cart.items {
+			if err := emit(logRecord{Op: opAdd, UserID: userID, ProductID: key.productID, SKU: key.sku, Quantity: quantity, Version: cart.version, At: cart.touched}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -2,20 +2,71 @@ package cartstore
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	pb "cartservice/proto"
 )
 
+// Returned when updating or removing a product or variant that is not in the cart
+var ErrItemNotFound = errors.New("item not found in cart")
+
+// Returned when a conditional change finds the cart at another version
+var ErrVersionMismatch = errors.New("cart version mismatch")
+
+// Returned when adding to a cart line would take it past MaxQuantity
+var ErrQuantityTooLarge = errors.New("cart line quantity too large")
+
+// Most units a cart line may hold
+const MaxQuantity = 1000
+
 // Cart interface
 type CartStore interface {
-	AddItem(ctx context.Context, userID, productID string, quantity int32, out *pb.Empty) (r *pb.Empty, err error)
-	EmptyCart(ctx context.Context, userID string) (*pb.Empty, error)
+	// Cart lines are keyed by product and variant SKU, which is empty for products without variants.
+	// AddItem returns ErrQuantityTooLarge rather than take a line past MaxQuantity.
+	AddItem(ctx context.Context, userID, productID, variantSKU string, quantity int32) (*pb.AddItemResponse, error)
+	// EmptyCart drops the cart, only if it is at expectedVersion when that is not nil
+	EmptyCart(ctx context.Context, userID string, expectedVersion *int64) (*pb.Empty, error)
 	GetCart(ctx context.Context, userID string) (*pb.Cart, error)
+	UpdateItemQuantity(ctx context.Context, userID, productID, variantSKU string, quantity int32) (*pb.Empty, error)
+	RemoveItem(ctx context.Context, userID, productID, variantSKU string) (*pb.Empty, error)
+	// MergeCarts adds every line of fromUserID's cart to toUserID's cart and drops the former,
+	// capping each merged line at maxPerLine when it is positive, and at MaxQuantity
+	MergeCarts(ctx context.Context, fromUserID, toUserID string, maxPerLine int32) (*pb.Cart, error)
+	// EvictIdle drops every cart last touched before idleSince and returns how many were dropped
+	EvictIdle(ctx context.Context, idleSince time.Time) (int, error)
 }
 
-// Instantiate CartStore
-func NewMemoryCartStore() CartStore {
-	return &memoryCartStore{
-		carts: make(map[string]map[string]int32),
+// Clock returns the current time, it is replaceable to control cart expiry
+type Clock func() time.Time
+
+// store options
+type options struct {
+	clock Clock
+	// records after which a file store compacts its log, the log default when 0
+	compactThreshold int
+}
+
+// Option configures a CartStore
+type Option func(*options)
+
+// Use clock instead of time.Now to timestamp cart changes
+func WithClock(clock Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// apply options over the defaults
+func newOptions(opts []Option) options {
+	o := options{clock: time.Now}
+	for _, opt := range opts {
+		opt(&o)
 	}
+	return o
+}
+
+// Instantiate CartStore
+func NewMemoryCartStore(opts ...Option) CartStore {
+	return newMemoryCartStore(newOptions(opts))
 }
@@ -3,55 +3,249 @@ package cartstore
 import (
 	"context"
 	"sync"
+	"time"
 
 	pb "cartservice/proto"
 )
 
+// A cart line is a product, or one variant of it when the SKU is set
+type lineKey struct {
+	productID string
+	sku       string
+}
+
+// A cart, its version and the last time it was touched
+type memoryCart struct {
+	items   map[lineKey]int32
+	version int64
+	touched time.Time
+}
+
 // Data is stored in memory using a nested map structure.
 type memoryCartStore struct {
 	// Read-write lock
 	sync.RWMutex
-	carts map[string]map[string]int32
+	carts map[string]*memoryCart
+	// last version handed out; versions are never reused, even after a cart is dropped
+	seq int64
+	now Clock
+}
+
+// Instantiate an empty memory store
+func newMemoryCartStore(o options) *memoryCartStore {
+	return &memoryCartStore{
+		carts: make(map[string]*memoryCart),
+		now:   o.clock,
+	}
 }
 
 // Add Item
-func (s *memoryCartStore) AddItem(ctx context.Context, userID, productID string, quantity int32, out *pb.Empty) (r *pb.Empty, err error) {
+func (s *memoryCartStore) AddItem(ctx context.Context, userID, productID, variantSKU string, quantity int32) (*pb.AddItemResponse, error) {
 	s.Lock()
 	defer s.Unlock()
-
-	if cart, ok := s.carts[userID]; ok {
-		if currentQuantity, ok := cart[productID]; ok {
-			cart[productID] = currentQuantity + quantity
-		} else {
-			cart[productID] = quantity
-		}
-		s.carts[userID] = cart
-	} else {
-		s.carts[userID] = map[string]int32{productID: quantity}
+	key := lineKey{productID, variantSKU}
+	if err := s.checkQuantity(userID, key, quantity); err != nil {
+		return nil, err
 	}
-	return out, nil
+	s.addItem(userID, key, quantity, s.now(), s.seq+1)
+	return &pb.AddItemResponse{Version: s.seq}, nil
 }
 
 // Empty Cart
-func (s *memoryCartStore) EmptyCart(ctx context.Context, userID string) (out *pb.Empty, err error) {
+func (s *memoryCartStore) EmptyCart(ctx context.Context, userID string, expectedVersion *int64) (out *pb.Empty, err error) {
 	s.Lock()
 	defer s.Unlock()
+	if err := s.checkVersion(userID, expectedVersion); err != nil {
+		return nil, err
+	}
 	out = new(pb.Empty)
-	delete(s.carts, userID)
+	s.emptyCart(userID)
 	return out, nil
 }
 
+// Update Item Quantity
+func (s *memoryCartStore) UpdateItemQuantity(ctx context.Context, userID, productID, variantSKU string, quantity int32) (*pb.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	key := lineKey{productID, variantSKU}
+	if !s.hasItem(userID, key) {
+		return nil, ErrItemNotFound
+	}
+	s.updateItemQuantity(userID, key, quantity, s.now(), s.seq+1)
+	return new(pb.Empty), nil
+}
+
+// Remove Item
+func (s *memoryCartStore) RemoveItem(ctx context.Context, userID, productID, variantSKU string) (*pb.Empty, error) {
+	s.Lock()
+	defer s.Unlock()
+	key := lineKey{productID, variantSKU}
+	if !s.hasItem(userID, key) {
+		return nil, ErrItemNotFound
+	}
+	s.removeItem(userID, key, s.now(), s.seq+1)
+	return new(pb.Empty), nil
+}
+
+// Merge Carts
+func (s *memoryCartStore) MergeCarts(ctx context.Context, fromUserID, toUserID string, maxPerLine int32) (*pb.Cart, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.mergeCarts(fromUserID, toUserID, maxPerLine, s.now(), s.seq+1)
+	return s.cart(toUserID), nil
+}
+
 // Get Cart
 func (s *memoryCartStore) GetCart(ctx context.Context, userID string) (*pb.Cart, error) {
-	s.RLock()
-	defer s.RUnlock()
+	// a read keeps the cart alive, so it needs the write lock
+	s.Lock()
+	defer s.Unlock()
+
+	if cart, ok := s.carts[userID]; ok {
+		cart.touched = s.now()
+	}
+	return s.cart(userID), nil
+}
+
+// Evict Idle Carts
+func (s *memoryCartStore) EvictIdle(ctx context.Context, idleSince time.Time) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	evicted := s.idleCarts(idleSince)
+	for _, userID := range evicted {
+		delete(s.carts, userID)
+	}
+	return len(evicted), nil
+}
+
+// The helpers below expect the caller to hold the write lock and take the
+// time and resulting version of the change explicitly, so the file store can
+// replay its log.
+
+// check that a cart line exists
+func (s *memoryCartStore) hasItem(userID string, key lineKey) bool {
+	cart, ok := s.carts[userID]
+	if !ok {
+		return false
+	}
+	_, ok = cart.items[key]
+	return ok
+}
+
+// check the version of a cart if one is expected
+func (s *memoryCartStore) checkVersion(userID string, expected *int64) error {
+	if expected == nil {
+		return nil
+	}
+	var version int64
+	if cart, ok := s.carts[userID]; ok {
+		version = cart.version
+	}
+	if version != *expected {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// check that adding a quantity keeps a cart line within MaxQuantity
+func (s *memoryCartStore) checkQuantity(userID string, key lineKey, quantity int32) error {
+	var current int32
+	if cart, ok := s.carts[userID]; ok {
+		current = cart.items[key]
+	}
+	if int64(current)+int64(quantity) > MaxQuantity {
+		return ErrQuantityTooLarge
+	}
+	return nil
+}
+
+// a user's cart, created if needed
+func (s *memoryCartStore) openCart(userID string) *memoryCart {
+	cart, ok := s.carts[userID]
+	if !ok {
+		cart = &memoryCart{items: make(map[lineKey]int32)}
+		s.carts[userID] = cart
+	}
+	return cart
+}
+
+// stamp a changed cart
+func (s *memoryCartStore) touch(cart *memoryCart, at time.Time, version int64) {
+	cart.touched = at
+	cart.version = version
+	s.seq = max(s.seq, version)
+}
+
+// add a quantity to a cart line, creating the cart if needed
+func (s *memoryCartStore) addItem(userID string, key lineKey, quantity int32, at time.Time, version int64) {
+	cart := s.openCart(userID)
+	cart.items[key] += quantity
+	s.touch(cart, at, version)
+}
 
+// set the quantity of a cart line
+func (s *memoryCartStore) updateItemQuantity(userID string, key lineKey, quantity int32, at time.Time, version int64) {
 	if cart, ok := s.carts[userID]; ok {
-		items := make([]*pb.CartItem, 0, len(cart))
-		for p, q := range cart {
-			items = append(items, &pb.CartItem{ProductId: p, Quantity: q})
+		cart.items[key] = quantity
+		s.touch(cart, at, version)
+	}
+}
+
+// drop a cart line, and the cart with its last line
+func (s *memoryCartStore) removeItem(userID string, key lineKey, at time.Time, version int64) {
+	if cart, ok := s.carts[userID]; ok {
+		delete(cart.items, key)
+		s.touch(cart, at, version)
+		if len(cart.items) == 0 {
+			delete(s.carts, userID)
+		}
+	}
+}
+
+// move the lines of one cart into another, capping the sums in int64 so
+// that they can't overflow
+func (s *memoryCartStore) mergeCarts(fromUserID, toUserID string, maxPerLine int32, at time.Time, version int64) {
+	from, ok := s.carts[fromUserID]
+	if !ok || fromUserID == toUserID {
+		return
+	}
+	limit := int64(MaxQuantity)
+	if maxPerLine > 0 {
+		limit = min(limit, int64(maxPerLine))
+	}
+	to := s.openCart(toUserID)
+	for key, quantity := range from.items {
+		to.items[key] = int32(min(int64(to.items[key])+int64(quantity), limit))
+	}
+	s.touch(to, at, version)
+	delete(s.carts, fromUserID)
+}
+
+// drop a cart
+func (s *memoryCartStore) emptyCart(userID string) {
+	delete(s.carts, userID)
+}
+
+// copy a cart into its protobuf form
+func (s *memoryCartStore) cart(userID string) *pb.Cart {
+	cart, ok := s.carts[userID]
+	if !ok {
+		return &pb.Cart{UserId: userID}
+	}
+	items := make([]*pb.CartItem, 0, len(cart.items))
+	for k, q := range cart.items {
+		items = append(items, &pb.CartItem{ProductId: k.productID, Quantity: q, VariantSku: k.sku})
+	}
+	return &pb.Cart{UserId: userID, Items: items, Version: cart.version}
+}
+
+// users whose cart was last touched before idleSince
+func (s *memoryCartStore) idleCarts(idleSince time.Time) []string {
+	var out []string
+	for userID, cart := range s.carts {
+		if cart.touched.Before(idleSince) {
+			out = append(out, userID)
 		}
-		return &pb.Cart{UserId: userID, Items: items}, nil
 	}
-	return &pb.Cart{UserId: userID}, nil
+	return out
 }
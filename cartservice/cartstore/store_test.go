@@ -0,0 +1,426 @@
+package cartstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Compact the log of a file store after n records
+func withCompactThreshold(n int) Option {
+	return func(o *options) {
+		o.compactThreshold = n
+	}
+}
+
+// A store under test, kept in dir when it persists
+type storeKind struct {
+	name       string
+	persistent bool
+	open       func(t *testing.T, dir string, opts ...Option) CartStore
+}
+
+var storeKinds = []storeKind{
+	{
+		name: "memory",
+		open: func(t *testing.T, dir string, opts ...Option) CartStore {
+			return NewMemoryCartStore(opts...)
+		},
+	},
+	{
+		name:       "file",
+		persistent: true,
+		open: func(t *testing.T, dir string, opts ...Option) CartStore {
+			return openFileStore(t, logPath(dir), opts...)
+		},
+	},
+}
+
+// run a test against every store, in a fresh directory
+func forEachStore(t *testing.T, test func(t *testing.T, kind storeKind, dir string)) {
+	for _, kind := range storeKinds {
+		t.Run(kind.name, func(t *testing.T) {
+			test(t, kind, t.TempDir())
+		})
+	}
+}
+
+// close a store and open it again, as after a restart
+func reopen(t *testing.T, kind storeKind, s CartStore, dir string, opts ...Option) CartStore {
+	t.Helper()
+	closeStore(t, s)
+	return kind.open(t, dir, opts...)
+}
+
+func logPath(dir string) string {
+	return filepath.Join(dir, "carts.log")
+}
+
+func openFileStore(t *testing.T, path string, opts ...Option) CartStore {
+	t.Helper()
+	s, err := NewFileCartStore(path, opts...)
+	if err != nil {
+		t.Fatalf("NewFileCartStore: %v", err)
+	}
+	t.Cleanup(func() { s.(io.Closer).Close() })
+	return s
+}
+
+func closeStore(t *testing.T, s CartStore) {
+	t.Helper()
+	if c, ok := s.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	}
+}
+
+// the lines of a cart by product and SKU
+func lines(t *testing.T, s CartStore, userID string) map[lineKey]int32 {
+	t.Helper()
+	cart, err := s.GetCart(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetCart(%s): %v", userID, err)
+	}
+	out := make(map[lineKey]int32)
+	for _, item := range cart.Items {
+		out[lineKey{item.ProductId, item.VariantSku}] = item.Quantity
+	}
+	return out
+}
+
+func wantLines(t *testing.T, s CartStore, userID string, want map[lineKey]int32) {
+	t.Helper()
+	got := lines(t, s, userID)
+	if len(got) != len(want) {
+		t.Fatalf("cart %s = %v, want %v", userID, got, want)
+	}
+	for key, quantity := range want {
+		if got[key] != quantity {
+			t.Fatalf("cart %s = %v, want %v", userID, got, want)
+		}
+	}
+}
+
+func add(t *testing.T, s CartStore, userID, productID, sku string, quantity int32) int64 {
+	t.Helper()
+	out, err := s.AddItem(context.Background(), userID, productID, sku, quantity)
+	if err != nil {
+		t.Fatalf("AddItem: %v", err)
+	}
+	return out.Version
+}
+
+func version(t *testing.T, s CartStore, userID string) int64 {
+	t.Helper()
+	cart, err := s.GetCart(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetCart(%s): %v", userID, err)
+	}
+	return cart.Version
+}
+
+func TestAddAndGet(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		s := kind.open(t, dir)
+		wantLines(t, s, "u1", nil)
+
+		add(t, s, "u1", "p1", "", 2)
+		add(t, s, "u1", "p1", "", 3)
+		add(t, s, "u1", "p1", "red", 1)
+		add(t, s, "u2", "p2", "", 1)
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 5, {"p1", "red"}: 1})
+		wantLines(t, s, "u2", map[lineKey]int32{{"p2", ""}: 1})
+	})
+}
+
+func TestUpdateAndRemove(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		ctx := context.Background()
+		s := kind.open(t, dir)
+		add(t, s, "u1", "p1", "", 2)
+		add(t, s, "u1", "p2", "", 1)
+
+		if _, err := s.UpdateItemQuantity(ctx, "u1", "p1", "", 7); err != nil {
+			t.Fatalf("UpdateItemQuantity: %v", err)
+		}
+		if _, err := s.UpdateItemQuantity(ctx, "u1", "p1", "red", 1); !errors.Is(err, ErrItemNotFound) {
+			t.Fatalf("UpdateItemQuantity of a missing variant = %v, want ErrItemNotFound", err)
+		}
+		if _, err := s.RemoveItem(ctx, "u1", "p2", ""); err != nil {
+			t.Fatalf("RemoveItem: %v", err)
+		}
+		if _, err := s.RemoveItem(ctx, "u1", "p2", ""); !errors.Is(err, ErrItemNotFound) {
+			t.Fatalf("RemoveItem twice = %v, want ErrItemNotFound", err)
+		}
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 7})
+
+		// removing the last line drops the cart
+		if _, err := s.RemoveItem(ctx, "u1", "p1", ""); err != nil {
+			t.Fatalf("RemoveItem: %v", err)
+		}
+		wantLines(t, s, "u1", nil)
+		if v := version(t, s, "u1"); v != 0 {
+			t.Fatalf("version of a dropped cart = %d, want 0", v)
+		}
+	})
+}
+
+func TestEmptyCart(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		ctx := context.Background()
+		s := kind.open(t, dir)
+		v := add(t, s, "u1", "p1", "", 1)
+
+		stale := v - 1
+		if _, err := s.EmptyCart(ctx, "u1", &stale); !errors.Is(err, ErrVersionMismatch) {
+			t.Fatalf("EmptyCart at a stale version = %v, want ErrVersionMismatch", err)
+		}
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 1})
+
+		if _, err := s.EmptyCart(ctx, "u1", &v); err != nil {
+			t.Fatalf("EmptyCart at the current version: %v", err)
+		}
+		wantLines(t, s, "u1", nil)
+
+		add(t, s, "u1", "p1", "", 1)
+		if _, err := s.EmptyCart(ctx, "u1", nil); err != nil {
+			t.Fatalf("EmptyCart: %v", err)
+		}
+		wantLines(t, s, "u1", nil)
+
+		// a missing cart is at version 0
+		zero := int64(0)
+		if _, err := s.EmptyCart(ctx, "u1", &zero); err != nil {
+			t.Fatalf("EmptyCart of a missing cart at version 0: %v", err)
+		}
+	})
+}
+
+func TestMergeCarts(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		ctx := context.Background()
+		s := kind.open(t, dir)
+		add(t, s, "guest", "p1", "", 4)
+		add(t, s, "guest", "p2", "blue", 1)
+		add(t, s, "user", "p1", "", 3)
+		add(t, s, "user", "p3", "", 1)
+
+		cart, err := s.MergeCarts(ctx, "guest", "user", 5)
+		if err != nil {
+			t.Fatalf("MergeCarts: %v", err)
+		}
+		if len(cart.Items) != 3 || cart.Version != version(t, s, "user") {
+			t.Fatalf("MergeCarts returned %v", cart)
+		}
+		wantLines(t, s, "user", map[lineKey]int32{{"p1", ""}: 5, {"p2", "blue"}: 1, {"p3", ""}: 1})
+		wantLines(t, s, "guest", nil)
+
+		// merging a missing or the same cart changes nothing
+		for _, from := range []string{"guest", "user"} {
+			if _, err := s.MergeCarts(ctx, from, "user", 0); err != nil {
+				t.Fatalf("MergeCarts from %s: %v", from, err)
+			}
+			wantLines(t, s, "user", map[lineKey]int32{{"p1", ""}: 5, {"p2", "blue"}: 1, {"p3", ""}: 1})
+		}
+	})
+}
+
+func TestQuantityLimits(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		ctx := context.Background()
+		s := kind.open(t, dir)
+		add(t, s, "u1", "p1", "", MaxQuantity-1)
+		v := add(t, s, "u1", "p1", "", 1)
+		if _, err := s.AddItem(ctx, "u1", "p1", "", 1); !errors.Is(err, ErrQuantityTooLarge) {
+			t.Fatalf("AddItem past the maximum = %v, want ErrQuantityTooLarge", err)
+		}
+		if _, err := s.AddItem(ctx, "u2", "p1", "", MaxQuantity+1); !errors.Is(err, ErrQuantityTooLarge) {
+			t.Fatalf("AddItem of more than the maximum = %v, want ErrQuantityTooLarge", err)
+		}
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: MaxQuantity})
+		wantLines(t, s, "u2", nil)
+		if got := version(t, s, "u1"); got != v {
+			t.Fatalf("version after a rejected AddItem = %d, want %d", got, v)
+		}
+
+		// merged lines stop at the maximum without a merge limit, and at the limit with one
+		add(t, s, "guest", "p1", "", MaxQuantity)
+		add(t, s, "guest", "p2", "", MaxQuantity)
+		if _, err := s.MergeCarts(ctx, "guest", "u1", 0); err != nil {
+			t.Fatalf("MergeCarts: %v", err)
+		}
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: MaxQuantity, {"p2", ""}: MaxQuantity})
+		add(t, s, "guest", "p1", "", MaxQuantity)
+		if _, err := s.MergeCarts(ctx, "guest", "u1", 5); err != nil {
+			t.Fatalf("MergeCarts: %v", err)
+		}
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 5, {"p2", ""}: MaxQuantity})
+
+		if kind.persistent {
+			s = reopen(t, kind, s, dir)
+			wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 5, {"p2", ""}: MaxQuantity})
+		}
+	})
+}
+
+// a merge into a line past the maximum, e.g. from a log written before there
+// was one, clamps the sum instead of letting it overflow
+func TestMergeDoesNotOverflow(t *testing.T) {
+	s := newMemoryCartStore(newOptions(nil))
+	s.addItem("user", lineKey{"p1", ""}, math.MaxInt32, s.now(), 1)
+	s.addItem("guest", lineKey{"p1", ""}, math.MaxInt32, s.now(), 2)
+	s.mergeCarts("guest", "user", 0, s.now(), 3)
+	wantLines(t, s, "user", map[lineKey]int32{{"p1", ""}: MaxQuantity})
+}
+
+func TestVersionsIncrease(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		ctx := context.Background()
+		s := kind.open(t, dir)
+		seen := map[int64]bool{0: true}
+		last := int64(0)
+		next := func(v int64) {
+			t.Helper()
+			if v <= last || seen[v] {
+				t.Fatalf("version %d after %d", v, last)
+			}
+			seen[v] = true
+			last = v
+		}
+
+		next(add(t, s, "u1", "p1", "", 1))
+		next(add(t, s, "u2", "p1", "", 1))
+		if _, err := s.UpdateItemQuantity(ctx, "u1", "p1", "", 3); err != nil {
+			t.Fatal(err)
+		}
+		next(version(t, s, "u1"))
+		if _, err := s.EmptyCart(ctx, "u1", nil); err != nil {
+			t.Fatal(err)
+		}
+		// a recreated cart never comes back at a version it had before
+		next(add(t, s, "u1", "p1", "", 1))
+		if _, err := s.MergeCarts(ctx, "u2", "u1", 0); err != nil {
+			t.Fatal(err)
+		}
+		next(version(t, s, "u1"))
+	})
+}
+
+func TestRestartReplay(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		if !kind.persistent {
+			t.Skip("store doesn't persist")
+		}
+		ctx := context.Background()
+		s := kind.open(t, dir)
+		add(t, s, "u1", "p1", "", 2)
+		add(t, s, "u1", "p2", "red", 1)
+		add(t, s, "u2", "p1", "", 1)
+		add(t, s, "guest", "p3", "", 1)
+		if _, err := s.UpdateItemQuantity(ctx, "u1", "p1", "", 4); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.RemoveItem(ctx, "u1", "p2", "red"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.MergeCarts(ctx, "guest", "u2", 0); err != nil {
+			t.Fatal(err)
+		}
+		v1 := version(t, s, "u1")
+		// the last version went to a cart that is gone, so only the log remembers it
+		add(t, s, "u3", "p1", "", 1)
+		if _, err := s.EmptyCart(ctx, "u3", nil); err != nil {
+			t.Fatal(err)
+		}
+		last := add(t, s, "u3", "p1", "", 1)
+		if _, err := s.EmptyCart(ctx, "u3", nil); err != nil {
+			t.Fatal(err)
+		}
+
+		s = reopen(t, kind, s, dir)
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 4})
+		wantLines(t, s, "u2", map[lineKey]int32{{"p1", ""}: 1, {"p3", ""}: 1})
+		wantLines(t, s, "guest", nil)
+		wantLines(t, s, "u3", nil)
+		if v := version(t, s, "u1"); v != v1 {
+			t.Fatalf("version after restart = %d, want %d", v, v1)
+		}
+		if v := add(t, s, "u3", "p1", "", 1); v <= last {
+			t.Fatalf("version after restart = %d, reuses a version up to %d", v, last)
+		}
+	})
+}
+
+func TestReplayCutsTornTail(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		if !kind.persistent {
+			t.Skip("store doesn't persist")
+		}
+		s := kind.open(t, dir)
+		add(t, s, "u1", "p1", "", 2)
+		closeStore(t, s)
+
+		// a crash in the middle of a write leaves half a line behind
+		f, err := os.OpenFile(logPath(dir), os.O_WRONLY|os.O_APPEND, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.WriteString(`1234abcd {"op":"add","user":"u1","prod`)
+		f.Close()
+
+		s = kind.open(t, dir)
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 2})
+		add(t, s, "u1", "p1", "", 1)
+		s = reopen(t, kind, s, dir)
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 3})
+	})
+}
+
+func TestCompaction(t *testing.T) {
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		if !kind.persistent {
+			t.Skip("store doesn't persist")
+		}
+		ctx := context.Background()
+		s := kind.open(t, dir, withCompactThreshold(8))
+		var last int64
+		for i := range 50 {
+			last = add(t, s, "u1", "p1", "", 1)
+			if i%5 == 4 {
+				if _, err := s.EmptyCart(ctx, "u2", nil); err != nil {
+					t.Fatal(err)
+				}
+				last = add(t, s, "u2", "p2", "", 1)
+			}
+		}
+		if _, err := s.EmptyCart(ctx, "u2", nil); err != nil {
+			t.Fatal(err)
+		}
+		size := fileSize(t, logPath(dir))
+
+		s = reopen(t, kind, s, dir, withCompactThreshold(8))
+		wantLines(t, s, "u1", map[lineKey]int32{{"p1", ""}: 50})
+		wantLines(t, s, "u2", nil)
+		if v := add(t, s, "u2", "p2", "", 1); v <= last {
+			t.Fatalf("version after compaction = %d, reuses a version up to %d", v, last)
+		}
+		// 60 records, compacted down to a header and a line or two
+		if max := int64(20 * 100); size > max {
+			t.Fatalf("log is %d bytes after compaction, want at most %d", size, max)
+		}
+	})
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.Size()
+}
@@ -0,0 +1,43 @@
+package cartstore
+
+import (
+	"context"
+	"time"
+)
+
+// Sweeper periodically evicts carts that have been idle for longer than TTL
+type Sweeper struct {
+	Store    CartStore
+	TTL      time.Duration
+	Interval time.Duration
+	// defaults to time.Now
+	Clock Clock
+	// called after every sweep with the number of evicted carts
+	OnSweep func(evicted int, err error)
+}
+
+// Sweep evicts idle carts once and returns how many were removed
+func (s *Sweeper) Sweep(ctx context.Context) (int, error) {
+	now := time.Now
+	if s.Clock != nil {
+		now = s.Clock
+	}
+	return s.Store.EvictIdle(ctx, now().Add(-s.TTL))
+}
+
+// Run sweeps every Interval until ctx is done
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			evicted, err := s.Sweep(ctx)
+			if s.OnSweep != nil {
+				s.OnSweep(evicted, err)
+			}
+		}
+	}
+}
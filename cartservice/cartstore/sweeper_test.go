@@ -0,0 +1,137 @@
+package cartstore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A clock that only moves when told to
+type fakeClock struct {
+	sync.Mutex
+	t time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{t: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.Lock()
+	defer c.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+	c.t = c.t.Add(d)
+}
+
+func TestSweeperEvictsIdleCarts(t *testing.T) {
+	const ttl = time.Hour
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		ctx := context.Background()
+		clock := newFakeClock()
+		s := kind.open(t, dir, WithClock(clock.Now))
+		sweeper := &Sweeper{Store: s, TTL: ttl, Clock: clock.Now}
+
+		add(t, s, "idle", "p1", "", 1)
+		add(t, s, "added", "p1", "", 1)
+		add(t, s, "read", "p1", "", 1)
+		clock.Advance(ttl - time.Minute)
+
+		// nothing is idle for the TTL yet
+		if n, err := sweeper.Sweep(ctx); err != nil || n != 0 {
+			t.Fatalf("Sweep before the TTL = %d, %v, want 0", n, err)
+		}
+
+		// a change or a read keeps a cart alive
+		add(t, s, "added", "p2", "", 1)
+		lines(t, s, "read")
+		clock.Advance(2 * time.Minute)
+
+		if n, err := sweeper.Sweep(ctx); err != nil || n != 1 {
+			t.Fatalf("Sweep past the TTL = %d, %v, want 1", n, err)
+		}
+		wantLines(t, s, "idle", nil)
+		wantLines(t, s, "added", map[lineKey]int32{{"p1", ""}: 1, {"p2", ""}: 1})
+		wantLines(t, s, "read", map[lineKey]int32{{"p1", ""}: 1})
+
+		// the reads above touched the kept carts again, so only a full TTL later are they idle
+		clock.Advance(ttl - time.Second)
+		if n, err := sweeper.Sweep(ctx); err != nil || n != 0 {
+			t.Fatalf("Sweep of recently read carts = %d, %v, want 0", n, err)
+		}
+		clock.Advance(2 * time.Second)
+		if n, err := sweeper.Sweep(ctx); err != nil || n != 2 {
+			t.Fatalf("Sweep of idle carts = %d, %v, want 2", n, err)
+		}
+		wantLines(t, s, "added", nil)
+		wantLines(t, s, "read", nil)
+	})
+}
+
+func TestEvictionSurvivesRestart(t *testing.T) {
+	const ttl = time.Hour
+	forEachStore(t, func(t *testing.T, kind storeKind, dir string) {
+		if !kind.persistent {
+			t.Skip("store doesn't persist")
+		}
+		ctx := context.Background()
+		clock := newFakeClock()
+		s := kind.open(t, dir, WithClock(clock.Now))
+		add(t, s, "idle", "p1", "", 1)
+		clock.Advance(ttl / 2)
+		add(t, s, "kept", "p1", "", 1)
+		clock.Advance(ttl/2 + time.Minute)
+
+		sweeper := &Sweeper{Store: s, TTL: ttl, Clock: clock.Now}
+		if n, err := sweeper.Sweep(ctx); err != nil || n != 1 {
+			t.Fatalf("Sweep = %d, %v, want 1", n, err)
+		}
+
+		s = reopen(t, kind, s, dir, WithClock(clock.Now))
+		wantLines(t, s, "idle", nil)
+		wantLines(t, s, "kept", map[lineKey]int32{{"p1", ""}: 1})
+	})
+}
+
+func TestSweeperRun(t *testing.T) {
+	clock := newFakeClock()
+	s := NewMemoryCartStore(WithClock(clock.Now))
+	add(t, s, "idle", "p1", "", 1)
+	clock.Advance(2 * time.Hour)
+
+	swept := make(chan int, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sweeper := &Sweeper{
+		Store:    s,
+		TTL:      time.Hour,
+		Interval: time.Millisecond,
+		Clock:    clock.Now,
+		OnSweep: func(evicted int, err error) {
+			if err != nil {
+				t.Errorf("sweep: %v", err)
+			}
+			if evicted > 0 {
+				select {
+				case swept <- evicted:
+				default:
+				}
+			}
+		},
+	}
+	go sweeper.Run(ctx)
+
+	select {
+	case n := <-swept:
+		if n != 1 {
+			t.Fatalf("Run evicted %d carts, want 1", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't evict the idle cart")
+	}
+}
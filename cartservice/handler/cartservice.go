@@ -4,17 +4,27 @@ import (
 	"cartservice/cartstore"
 	pb "cartservice/proto"
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // cart service structure
 type CartService struct {
+	pb.UnimplementedCartServiceServer
 	Store cartstore.CartStore
+	// upper bound on the quantity of a line produced by MergeCarts, 0 means unlimited
+	MaxPerLine int32
 }
 
 // Call to cartstore to add an item
-func (s *CartService) AddItem(ctx context.Context, in *pb.AddItemRequest) (out *pb.Empty, err error) {
-	out = new(pb.Empty)
-	return s.Store.AddItem(ctx, in.UserId, in.Item.ProductId, in.Item.Quantity, out)
+func (s *CartService) AddItem(ctx context.Context, in *pb.AddItemRequest) (out *pb.AddItemResponse, err error) {
+	if err := validateItem(in.GetItem()); err != nil {
+		return nil, err
+	}
+	out, err = s.Store.AddItem(ctx, in.UserId, in.Item.ProductId, in.Item.VariantSku, in.Item.Quantity)
+	return out, storeError(err)
 }
 
 // Call to cartstore to get the cart
@@ -26,10 +36,65 @@ func (s *CartService) GetCart(ctx context.Context, in *pb.GetCartRequest) (out *
 	}
 	out.UserId = in.UserId
 	out.Items = cart.Items
+	out.Version = cart.Version
 	return out, nil
 }
 
 // Call to cartstore to empty the cart
 func (s *CartService) EmptyCart(ctx context.Context, in *pb.EmptyCartRequest) (out *pb.Empty, err error) {
-	return s.Store.EmptyCart(ctx, in.UserId)
+	out, err = s.Store.EmptyCart(ctx, in.UserId, in.ExpectedVersion)
+	return out, storeError(err)
+}
+
+// Call to cartstore to change the quantity of an item
+func (s *CartService) UpdateItemQuantity(ctx context.Context, in *pb.UpdateItemQuantityRequest) (out *pb.Empty, err error) {
+	if err := validateItem(in.GetItem()); err != nil {
+		return nil, err
+	}
+	out, err = s.Store.UpdateItemQuantity(ctx, in.UserId, in.Item.ProductId, in.Item.VariantSku, in.Item.Quantity)
+	return out, storeError(err)
+}
+
+// Call to cartstore to remove an item
+func (s *CartService) RemoveItem(ctx context.Context, in *pb.RemoveItemRequest) (out *pb.Empty, err error) {
+	if in.ProductId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product id is required")
+	}
+	out, err = s.Store.RemoveItem(ctx, in.UserId, in.ProductId, in.VariantSku)
+	return out, storeError(err)
+}
+
+// Call to cartstore to merge one cart into another
+func (s *CartService) MergeCarts(ctx context.Context, in *pb.MergeCartsRequest) (out *pb.Cart, err error) {
+	if in.FromUserId == "" || in.ToUserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "both user ids are required")
+	}
+	return s.Store.MergeCarts(ctx, in.FromUserId, in.ToUserId, s.MaxPerLine)
+}
+
+// check the item of a request
+func validateItem(item *pb.CartItem) error {
+	if item.GetProductId() == "" {
+		return status.Errorf(codes.InvalidArgument, "product id is required")
+	}
+	if item.GetQuantity() <= 0 {
+		return status.Errorf(codes.InvalidArgument, "quantity must be positive, got %d", item.GetQuantity())
+	}
+	if item.GetQuantity() > cartstore.MaxQuantity {
+		return status.Errorf(codes.InvalidArgument, "quantity must be at most %d, got %d", cartstore.MaxQuantity, item.GetQuantity())
+	}
+	return nil
+}
+
+// map cartstore errors to grpc status
+func storeError(err error) error {
+	switch {
+	case errors.Is(err, cartstore.ErrItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, cartstore.ErrVersionMismatch):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, cartstore.ErrQuantityTooLarge):
+		return status.Errorf(codes.InvalidArgument, "%v, at most %d units", err, cartstore.MaxQuantity)
+	}
+	return err
 }
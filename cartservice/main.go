@@ -4,9 +4,13 @@ import (
 	"cartservice/cartstore"
 	handler "cartservice/handler"
 	pb "cartservice/proto"
+	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"google.golang.org/grpc"
@@ -15,6 +19,70 @@ import (
 const PORT = 50011
 const ADDRESS = "127.0.0.1"
 
+// Cart store selection, e.g. CART_STORE=file CART_STORE_PATH=data/carts.log
+const (
+	STORE_ENV          = "CART_STORE"
+	STORE_PATH_ENV     = "CART_STORE_PATH"
+	DEFAULT_STORE_PATH = "data/carts.log"
+)
+
+// Idle cart expiry, e.g. CART_TTL=48h CART_SWEEP_INTERVAL=10m; a TTL of 0 keeps carts forever.
+// The default TTL matches the lifetime of the frontend session cookie.
+const (
+	TTL_ENV                = "CART_TTL"
+	SWEEP_INTERVAL_ENV     = "CART_SWEEP_INTERVAL"
+	DEFAULT_TTL            = 48 * time.Hour
+	DEFAULT_SWEEP_INTERVAL = 10 * time.Minute
+)
+
+// Maximum quantity of a cart line after merging a guest cart into an account, e.g. CART_MAX_PER_LINE=10
+const (
+	MAX_PER_LINE_ENV     = "CART_MAX_PER_LINE"
+	DEFAULT_MAX_PER_LINE = 10
+)
+
+// read a duration from the environment
+func durationEnv(name string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return d, nil
+}
+
+// init the cart store selected by the environment
+func newCartStore() (cartstore.CartStore, error) {
+	switch kind := os.Getenv(STORE_ENV); kind {
+	case "", "memory":
+		return cartstore.NewMemoryCartStore(), nil
+	case "file":
+		path := os.Getenv(STORE_PATH_ENV)
+		if path == "" {
+			path = DEFAULT_STORE_PATH
+		}
+		return cartstore.NewFileCartStore(path)
+	default:
+		return nil, fmt.Errorf("unknown cart store %q", kind)
+	}
+}
+
+// read an integer from the environment
+func intEnv(name string, fallback int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return n, nil
+}
+
 func main() {
 	ipport := ADDRESS + ":" + strconv.Itoa(PORT)
 	// -------------Register on consul---------------
@@ -43,12 +111,49 @@ func main() {
 		return
 	}
 
+	// init cart store
+	store, err_store := newCartStore()
+	if err_store != nil {
+		fmt.Println("cart store init error:", err_store)
+		return
+	}
+
+	// read cart merge limit
+	maxPerLine, err_max := intEnv(MAX_PER_LINE_ENV, DEFAULT_MAX_PER_LINE)
+	if err_max != nil {
+		fmt.Println("cart merge config error:", err_max)
+		return
+	}
+
+	// start idle cart sweeper
+	ttl, err_ttl := durationEnv(TTL_ENV, DEFAULT_TTL)
+	interval, err_interval := durationEnv(SWEEP_INTERVAL_ENV, DEFAULT_SWEEP_INTERVAL)
+	if err_ttl != nil || err_interval != nil {
+		fmt.Println("cart expiry config error:", errors.Join(err_ttl, err_interval))
+		return
+	}
+	if ttl > 0 && interval > 0 {
+		sweeper := &cartstore.Sweeper{
+			Store:    store,
+			TTL:      ttl,
+			Interval: interval,
+			OnSweep: func(evicted int, err error) {
+				if err != nil {
+					fmt.Println("cart sweep error:", err)
+				} else if evicted > 0 {
+					fmt.Printf("Evicted %d idle carts\n", evicted)
+				}
+			},
+		}
+		go sweeper.Run(context.Background())
+	}
+
 	//-----------------------grpc code--------------------------------
 	// init grpc server
 	grpcServer := grpc.NewServer()
 
 	// register grpc service
-	pb.RegisterCartServiceServer(grpcServer, &handler.CartService{Store: cartstore.NewMemoryCartStore()})
+	pb.RegisterCartServiceServer(grpcServer, &handler.CartService{Store: store, MaxPerLine: int32(maxPerLine)})
 
 	// start grpc listen
 	listen, err := net.Listen("tcp", ipport)
@@ -3,15 +3,24 @@ package handler
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
+	"money"
+	"strings"
+	"time"
+
+	"checkoutservice/orderstore"
 
 	"github.com/google/uuid"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"checkoutservice/money"
 	pb "checkoutservice/proto"
 )
 
@@ -21,180 +30,444 @@ var (
 	logger = log.New(&buf, "logger: ", log.LstdFlags)
 )
 
+// How long stock stays reserved for an order that is being paid for
+const reservationTTL = 5 * time.Minute
+
 type CheckoutService struct {
 	CartService           pb.CartServiceClient
 	CurrencyService       pb.CurrencyServiceClient
 	EmailService          pb.EmailServiceClient
+	InventoryService      pb.InventoryServiceClient
 	PaymentService        pb.PaymentServiceClient
 	ProductCatalogService pb.ProductCatalogServiceClient
 	ShippingService       pb.ShippingServiceClient
+	Orders                orderstore.OrderStore
+	// idempotency keys of orders being placed
+	keys keyLocks
 }
 
-// Place Order
+// PlaceOrder runs the order as a saga: record it as pending, reserve the stock,
+// claim the cart, charge the card, commit the stock, ship, then record it as
+// placed. The stock is committed as soon as it is paid for, before its
+// reservation can expire. When a step fails, including the final record, the
+// completed ones are compensated (shipment canceled, payment refunded, cart
+// restored, stock released or returned, order recorded as failed) and the error
+// is returned with a status code telling the client whether to fix its
+// request, review its cart or retry later.
 func (s *CheckoutService) PlaceOrder(ctx context.Context, in *pb.PlaceOrderRequest) (out *pb.PlaceOrderResponse, e error) {
 	logger.Printf("[PlaceOrder] user_id=%q user_currency=%q", in.UserId, in.UserCurrency)
 
+	// a repeated request gets the order placed the first time
+	if in.IdempotencyKey != "" {
+		unlock, err := s.keys.lock(ctx, in.UserId+"\x00"+in.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+		if prev, err := s.previousOrder(ctx, in.UserId, in.IdempotencyKey); prev != nil || err != nil {
+			return prev, err
+		}
+	}
+
 	out = new(pb.PlaceOrderResponse)
 	orderID, err := uuid.NewUUID()
 	if err != nil {
-		log.Fatal(err)
-		return nil, status.Errorf(codes.Internal, "Failed to generate Order Id")
+		return nil, status.Errorf(codes.Internal, "Failed to generate Order Id: %+v", err)
 	}
 
-	prep, err := s.prepareOrderItemsAndShippingQuoteFromCart(ctx, in.UserId, in.UserCurrency, in.Address)
+	// an undeliverable address fails the order before anything is reserved or charged
+	check, err := s.ShippingService.ValidateAddress(ctx, &pb.ValidateAddressRequest{Address: in.Address})
 	if err != nil {
-		log.Fatal(err)
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, stepError(err, codes.Unavailable, "Failed to validate address")
+	}
+	if !check.GetValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "undeliverable address: %s", addressIssues(check.GetIssues()))
 	}
+	address := check.GetNormalized()
 
-	total := &pb.Money{CurrencyCode: in.UserCurrency, Units: 0, Nanos: 0}
-	total = money.Must(money.Sum(total, prep.shippingCostLocalized))
-	for _, it := range prep.orderItems {
-		multPrice := money.MultiplySlow(it.Cost, uint32(it.GetItem().GetQuantity()))
-		total = money.Must(money.Sum(total, multPrice))
+	prep, err := s.prepareOrderItemsAndShippingQuoteFromCart(ctx, in.UserId, in.UserCurrency, address, in.ShippingOptionId)
+	if err != nil {
+		return nil, stepError(err, codes.Unavailable, "Failed to prepare order")
+	}
+	if len(prep.cartItems) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "cart is empty")
+	}
+
+	total, err := orderTotal(in.UserCurrency, prep)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to compute order total: %+v", err)
+	}
+
+	now := timestamppb.Now()
+	order := &pb.Order{
+		OrderId:         orderID.String(),
+		UserId:          in.UserId,
+		Email:           in.Email,
+		Items:           prep.orderItems,
+		ShippingCost:    prep.shippingCostLocalized,
+		Total:           &pb.Money{CurrencyCode: total.CurrencyCode, Units: total.Units, Nanos: total.Nanos},
+		ShippingAddress: address,
+		Status:          pb.OrderStatus_ORDER_STATUS_PENDING,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		IdempotencyKey:  in.IdempotencyKey,
+		ShippingOption:  prep.shippingOption,
+	}
+	if err := s.Orders.Save(ctx, order); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Failed to record order: %+v", err)
+	}
+
+	saga := &orderSaga{orderID: orderID.String()}
+	saga.onFailure("record failure", func(ctx context.Context) error {
+		order.Status = pb.OrderStatus_ORDER_STATUS_FAILED
+		order.UpdatedAt = timestamppb.Now()
+		return s.Orders.Save(ctx, order)
+	})
+
+	// hold the stock of the order until it is paid for
+	if err := s.reserveStock(ctx, orderID.String(), prep.cartItems); err != nil {
+		logger.Printf("Failed to reserve stock for order %s: %+v", orderID, err)
+		saga.compensate(ctx)
+		return nil, err
+	}
+	// releases the reservation, or returns the stock once it is committed
+	saga.onFailure("release stock", func(ctx context.Context) error {
+		_, err := s.InventoryService.Release(ctx, &pb.ReleaseRequest{OrderId: orderID.String()})
+		return err
+	})
+
+	// claim exactly the cart that is about to be charged, items added since it was read stay in place
+	if err := s.emptyUserCart(ctx, in.UserId, prep.cartVersion); err != nil {
+		logger.Printf("Failed to empty user's cart: %s: %+v", in.UserId, err)
+		saga.compensate(ctx)
+		if status.Code(err) == codes.Aborted {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unavailable, "Failed to empty cart: %+v", err)
 	}
+	saga.onFailure("restore cart", func(ctx context.Context) error {
+		return s.restoreUserCart(ctx, in.UserId, prep.cartItems)
+	})
 
-	txID, err := s.chargeCard(ctx, total, in.CreditCard)
+	txID, err := s.chargeCard(ctx, order.Total, in.CreditCard, paymentKey(in.UserId, in.IdempotencyKey))
 	if err != nil {
-		log.Fatal(err)
-		return nil, status.Errorf(codes.Internal, "Failed to change card: %+v", err)
+		logger.Printf("Failed to charge card for order %s: %+v", orderID, err)
+		saga.compensate(ctx)
+		return nil, stepError(err, codes.Unavailable, "Failed to charge card")
 	}
 	logger.Printf("Payment (transaction_id: %s)", txID)
+	order.TransactionId = txID
+	saga.onFailure("refund payment "+txID, func(ctx context.Context) error {
+		_, err := s.PaymentService.Refund(ctx, &pb.RefundRequest{TransactionId: txID})
+		return err
+	})
+
+	// the stock is paid for, take it out of the inventory for good
+	if err := s.commitStock(ctx, orderID.String()); err != nil {
+		logger.Printf("Failed to commit stock of order %s: %+v", orderID, err)
+		saga.compensate(ctx)
+		return nil, err
+	}
 
-	shippingTrackingID, err := s.shipOrder(ctx, in.Address, prep.cartItems)
+	shippingTrackingID, err := s.shipOrder(ctx, address, prep.cartItems, prep.shippingOption.GetId())
 	if err != nil {
-		log.Fatal(err)
-		return nil, status.Errorf(codes.Unavailable, "Shipping Error: %+v", err)
+		logger.Printf("Failed to ship order %s: %+v", orderID, err)
+		saga.compensate(ctx)
+		return nil, stepError(err, codes.Unavailable, "Shipping Error")
 	}
+	saga.onFailure("cancel shipment "+shippingTrackingID, func(ctx context.Context) error {
+		_, err := s.ShippingService.CancelShipment(ctx, &pb.CancelShipmentRequest{TrackingId: shippingTrackingID, Note: "order failed"})
+		return err
+	})
 
-	if err := s.emptyUserCart(ctx, in.UserId); err != nil {
-		logger.Printf("Failed to empty user's cart: %s: %+v", in.UserId, err)
-		log.Fatal(err)
+	// an order that can't be recorded as placed is undone, so that its pending
+	// record doesn't block retries with the same idempotency key
+	order.ShippingTrackingId = shippingTrackingID
+	order.Status = pb.OrderStatus_ORDER_STATUS_PLACED
+	order.UpdatedAt = timestamppb.Now()
+	if err := s.Orders.Save(ctx, order); err != nil {
+		logger.Printf("Failed to record placed order %s: %+v", orderID, err)
+		saga.compensate(ctx)
+		return nil, status.Errorf(codes.Unavailable, "Failed to record order: %+v", err)
 	}
 
 	orderResult := &pb.OrderResult{
 		OrderId:            orderID.String(),
 		ShippingTrackingId: shippingTrackingID,
 		ShippingCost:       prep.shippingCostLocalized,
-		ShippingAddress:    in.Address,
+		ShippingAddress:    address,
 		Items:              prep.orderItems,
 	}
 
+	// a missing confirmation email doesn't undo the order
 	if err := s.sendOrderConfirmation(ctx, in.Email, orderResult); err != nil {
 		logger.Printf("Failed to send order confirmation message: %q: %+v", in.Email, err)
-		log.Fatal(err)
 	} else {
 		logger.Printf("Order Confirmation Email Sent Successfully: %q", in.Email)
-		log.Printf(in.Email)
 	}
 	out.Order = orderResult
 	return out, nil
 }
 
+// stepError reports a failed step, keeping the code of a client error from the
+// downstream service (e.g. an invalid card) and using fallback for the others
+func stepError(err error, fallback codes.Code, msg string) error {
+	code := status.Code(err)
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		code = status.FromContextError(err).Code()
+	}
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
+		return status.Errorf(code, "%s: %s", msg, status.Convert(err).Message())
+	case codes.Canceled, codes.DeadlineExceeded:
+		return status.Errorf(code, "%s: %+v", msg, err)
+	default:
+		return status.Errorf(fallback, "%s: %+v", msg, err)
+	}
+}
+
+// issues of an address as one line
+func addressIssues(issues []*pb.AddressIssue) string {
+	msgs := make([]string, len(issues))
+	for i, issue := range issues {
+		msgs[i] = issue.GetField() + ": " + issue.GetMessage()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // order preparation
 type orderPrep struct {
 	orderItems            []*pb.OrderItem
 	cartItems             []*pb.CartItem
+	cartVersion           int64
+	shippingOption        *pb.ShippingOption
 	shippingCostLocalized *pb.Money
 }
 
-// Preparation of orders and shipping
-func (s *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context.Context, userID, userCurrency string, address *pb.Address) (orderPrep, error) {
+// Preparation of orders and shipping: after reading the cart, the products of
+// its lines are fetched and priced and the shipping is quoted concurrently
+func (s *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context.Context, userID, userCurrency string, address *pb.Address, shippingOptionID string) (orderPrep, error) {
 	var out orderPrep
 
-	cartItems, err := s.getUserCart(ctx, userID)
-	if err != nil {
-		return out, fmt.Errorf("get cart failed: %+v", err)
-	}
-	orderItems, err := s.prepOrderItems(ctx, cartItems, userCurrency)
-	if err != nil {
-		return out, fmt.Errorf("prepare order failed: %+v", err)
-	}
-	shippingUSD, err := s.quoteShipping(ctx, address, cartItems)
+	cart, err := s.getUserCart(ctx, userID)
 	if err != nil {
-		return out, fmt.Errorf("quote shipping failed: %+v", err)
+		return out, fmt.Errorf("get cart failed: %w", err)
 	}
-	shippingPrice, err := s.convertCurrency(ctx, shippingUSD, userCurrency)
+	cartItems := cart.GetItems()
+	orderItems := make([]*pb.OrderItem, len(cartItems))
+	var (
+		shippingOption *pb.ShippingOption
+		shippingPrice  *pb.Money
+	)
+
+	// task 0 quotes the shipping, task i prices cart line i-1
+	err = fanOut(ctx, len(cartItems)+1, prepParallelism, func(ctx context.Context, i int) error {
+		if i > 0 {
+			item, err := s.prepOrderItem(ctx, cartItems[i-1], userCurrency)
+			if err != nil {
+				return fmt.Errorf("prepare order failed: %w", err)
+			}
+			orderItems[i-1] = item
+			return nil
+		}
+		option, err := s.quoteShipping(ctx, address, cartItems, shippingOptionID)
+		if err != nil {
+			return fmt.Errorf("quote shipping failed: %w", err)
+		}
+		price, err := s.convertCurrency(ctx, option.GetCostUsd(), userCurrency)
+		if err != nil {
+			return fmt.Errorf("failed currency conversion: %w", err)
+		}
+		shippingOption, shippingPrice = option, price
+		return nil
+	})
 	if err != nil {
-		return out, fmt.Errorf("failed currency conversion: %+v", err)
+		return out, err
 	}
 
+	out.shippingOption = shippingOption
 	out.shippingCostLocalized = shippingPrice
 	out.cartItems = cartItems
+	out.cartVersion = cart.GetVersion()
 	out.orderItems = orderItems
 	return out, nil
 }
 
-// quote shipping
-func (s *CheckoutService) quoteShipping(ctx context.Context, address *pb.Address, items []*pb.CartItem) (*pb.Money, error) {
+// order total in the user currency, rounded to exactly what the frontend displays
+func orderTotal(userCurrency string, prep orderPrep) (money.Money, error) {
+	total, err := money.Sum(money.Zero(userCurrency), money.From(prep.shippingCostLocalized))
+	if err != nil {
+		return money.Money{}, err
+	}
+	for _, it := range prep.orderItems {
+		multPrice, err := money.Multiply(money.From(it.GetCost()), int64(it.GetItem().GetQuantity()))
+		if err != nil {
+			return money.Money{}, err
+		}
+		if total, err = money.Sum(total, multPrice); err != nil {
+			return money.Money{}, err
+		}
+	}
+	return money.Round(total)
+}
+
+// quote shipping and pick the chosen option, the cheapest when none is
+func (s *CheckoutService) quoteShipping(ctx context.Context, address *pb.Address, items []*pb.CartItem, optionID string) (*pb.ShippingOption, error) {
 	shippingQuote, err := s.ShippingService.GetQuote(ctx, &pb.GetQuoteRequest{
 		Address: address,
 		Items:   items,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("shipping quote failed: %+v", err)
+		return nil, fmt.Errorf("shipping quote failed: %w", err)
+	}
+	options := shippingQuote.GetOptions()
+	if optionID == "" {
+		if len(options) > 0 {
+			return options[0], nil
+		}
+		// the cheapest rate alone, as quoted for an empty cart
+		return &pb.ShippingOption{Carrier: shippingQuote.GetCarrier(), CostUsd: shippingQuote.GetCostUsd()}, nil
+	}
+	for _, o := range options {
+		if o.GetId() == optionID {
+			return o, nil
+		}
 	}
-	return shippingQuote.GetCostUsd(), nil
+	return nil, status.Errorf(codes.InvalidArgument, "shipping option %q is not available for this order", optionID)
 }
 
 // get user cart
-func (s *CheckoutService) getUserCart(ctx context.Context, userID string) ([]*pb.CartItem, error) {
+func (s *CheckoutService) getUserCart(ctx context.Context, userID string) (*pb.Cart, error) {
 	cart, err := s.CartService.GetCart(ctx, &pb.GetCartRequest{UserId: userID})
 	if err != nil {
-		return nil, fmt.Errorf("get user cart failed: %+v", err)
+		return nil, fmt.Errorf("get user cart failed: %w", err)
 	}
-	return cart.GetItems(), nil
+	return cart, nil
 }
 
-// empty user cart
-func (s *CheckoutService) emptyUserCart(ctx context.Context, userID string) error {
-	if _, err := s.CartService.EmptyCart(ctx, &pb.EmptyCartRequest{UserId: userID}); err != nil {
+// empty user cart if it is still at the given version
+func (s *CheckoutService) emptyUserCart(ctx context.Context, userID string, version int64) error {
+	if _, err := s.CartService.EmptyCart(ctx, &pb.EmptyCartRequest{UserId: userID, ExpectedVersion: &version}); err != nil {
+		if status.Code(err) == codes.Aborted {
+			return status.Errorf(codes.Aborted, "cart changed during checkout, please review it and try again")
+		}
 		return fmt.Errorf("empty user cart failed: %+v", err)
 	}
 	return nil
 }
 
-// prep order items
-func (s *CheckoutService) prepOrderItems(ctx context.Context, items []*pb.CartItem, userCurrency string) ([]*pb.OrderItem, error) {
-	out := make([]*pb.OrderItem, len(items))
-	for i, item := range items {
-		product, err := s.ProductCatalogService.GetProduct(ctx, &pb.GetProductRequest{Id: item.GetProductId()})
-		if err != nil {
-			return nil, fmt.Errorf("get prouduct failed #%q", item.GetProductId())
+// put claimed items back in the cart of an order that failed, next to whatever was added since
+func (s *CheckoutService) restoreUserCart(ctx context.Context, userID string, items []*pb.CartItem) error {
+	for _, item := range items {
+		if _, err := s.CartService.AddItem(ctx, &pb.AddItemRequest{UserId: userID, Item: item}); err != nil {
+			return fmt.Errorf("restore user cart failed: %w", err)
 		}
-		price, err := s.convertCurrency(ctx, product.GetPriceUsd(), userCurrency)
-		if err != nil {
-			return nil, fmt.Errorf("currency conversion failed %q to %s", item.GetProductId(), userCurrency)
+	}
+	return nil
+}
+
+// reserve the stock of the cart items for an order
+func (s *CheckoutService) reserveStock(ctx context.Context, orderID string, items []*pb.CartItem) error {
+	_, err := s.InventoryService.Reserve(ctx, &pb.ReserveRequest{
+		OrderId: orderID,
+		Items:   items,
+		Ttl:     durationpb.New(reservationTTL),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition, codes.NotFound:
+		return status.Errorf(codes.FailedPrecondition, "Some items are no longer available: %s", status.Convert(err).Message())
+	default:
+		return status.Errorf(codes.Unavailable, "Failed to reserve stock: %+v", err)
+	}
+}
+
+// commit the reserved stock of an order
+func (s *CheckoutService) commitStock(ctx context.Context, orderID string) error {
+	_, err := s.InventoryService.Commit(ctx, &pb.CommitRequest{OrderId: orderID})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return status.Errorf(codes.Aborted, "stock reservation expired during checkout, please try again")
+	default:
+		return status.Errorf(codes.Unavailable, "Failed to commit stock: %+v", err)
+	}
+}
+
+// prep order item
+func (s *CheckoutService) prepOrderItem(ctx context.Context, item *pb.CartItem, userCurrency string) (*pb.OrderItem, error) {
+	product, err := s.ProductCatalogService.GetProduct(ctx, &pb.GetProductRequest{Id: item.GetProductId()})
+	if err != nil {
+		return nil, fmt.Errorf("get product %q failed: %w", item.GetProductId(), err)
+	}
+	priceUSD, err := variantPrice(product, item.GetVariantSku())
+	if err != nil {
+		return nil, err
+	}
+	price, err := s.convertCurrency(ctx, priceUSD, userCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("currency conversion failed %q to %s: %w", item.GetProductId(), userCurrency, err)
+	}
+	return &pb.OrderItem{Item: item, Cost: price}, nil
+}
+
+// USD price of a product variant; products with variants can only be ordered as one of them
+func variantPrice(p *pb.Product, sku string) (*pb.Money, error) {
+	if sku == "" {
+		if len(p.GetVariants()) > 0 {
+			return nil, fmt.Errorf("product %s requires a variant", p.GetId())
 		}
-		out[i] = &pb.OrderItem{Item: item, Cost: price}
+		return p.GetPriceUsd(), nil
 	}
-	return out, nil
+	for _, v := range p.GetVariants() {
+		if v.GetSku() != sku {
+			continue
+		}
+		if v.GetPriceUsd() != nil {
+			return v.GetPriceUsd(), nil
+		}
+		return p.GetPriceUsd(), nil
+	}
+	return nil, fmt.Errorf("product %s has no variant %s", p.GetId(), sku)
 }
 
 // convert currency
 func (s *CheckoutService) convertCurrency(ctx context.Context, from *pb.Money, toCurrency string) (*pb.Money, error) {
-	result, err := s.CurrencyService.Convert(context.TODO(), &pb.CurrencyConversionRequest{
+	result, err := s.CurrencyService.Convert(ctx, &pb.CurrencyConversionRequest{
 		From:   from,
 		ToCode: toCurrency,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("currency conversion failed: %+v", err)
+		return nil, fmt.Errorf("currency conversion failed: %w", err)
 	}
 	return result, err
 }
 
 // charge card
-func (s *CheckoutService) chargeCard(ctx context.Context, amount *pb.Money, paymentInfo *pb.CreditCardInfo) (string, error) {
+func (s *CheckoutService) chargeCard(ctx context.Context, amount *pb.Money, paymentInfo *pb.CreditCardInfo, idempotencyKey string) (string, error) {
 	paymentResp, err := s.PaymentService.Charge(ctx, &pb.ChargeRequest{
-		Amount:     amount,
-		CreditCard: paymentInfo,
+		Amount:         amount,
+		CreditCard:     paymentInfo,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
-		return "", fmt.Errorf("cannot replace card: %+v", err)
+		return "", fmt.Errorf("cannot charge card: %w", err)
 	}
 	return paymentResp.GetTransactionId(), nil
 }
 
+// The idempotency key of a charge. Payment keys are shared by every caller, so
+// the user's key is scoped to the user, like the orders placed with it.
+func paymentKey(userID, key string) string {
+	if key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(userID + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
 // send order confirmation
 func (s *CheckoutService) sendOrderConfirmation(ctx context.Context, email string, order *pb.OrderResult) error {
 	_, err := s.EmailService.SendOrderConfirmation(ctx, &pb.SendOrderConfirmationRequest{
@@ -205,13 +478,14 @@ func (s *CheckoutService) sendOrderConfirmation(ctx context.Context, email strin
 }
 
 // ship order
-func (s *CheckoutService) shipOrder(ctx context.Context, address *pb.Address, items []*pb.CartItem) (string, error) {
+func (s *CheckoutService) shipOrder(ctx context.Context, address *pb.Address, items []*pb.CartItem, optionID string) (string, error) {
 	resp, err := s.ShippingService.ShipOrder(ctx, &pb.ShipOrderRequest{
-		Address: address,
-		Items:   items,
+		Address:          address,
+		Items:            items,
+		ShippingOptionId: optionID,
 	})
 	if err != nil {
-		return "", fmt.Errorf("shipping failed: %+v", err)
+		return "", fmt.Errorf("shipping failed: %w", err)
 	}
 	return resp.GetTrackingId(), nil
 }
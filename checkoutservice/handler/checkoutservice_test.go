@@ -0,0 +1,423 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"checkoutservice/orderstore"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "checkoutservice/proto"
+)
+
+// Downstream services of checkout in one fake. Every call is recorded by its
+// method name, the one named in fail returns err, and the calls that prepare
+// an order wait for delay, like a service over the network.
+type fakeServices struct {
+	pb.CartServiceClient
+	pb.CurrencyServiceClient
+	pb.EmailServiceClient
+	pb.InventoryServiceClient
+	pb.PaymentServiceClient
+	pb.ProductCatalogServiceClient
+	pb.ShippingServiceClient
+
+	fail  string
+	err   error
+	delay time.Duration
+	items []*pb.CartItem
+
+	mu    sync.Mutex
+	calls []string
+	// idempotency keys of the charges
+	chargeKeys []string
+}
+
+// the calls that undo a step of an order
+var compensationCalls = []string{"CancelShipment", "Refund", "AddItem", "Release"}
+
+func newFakeServices() *fakeServices {
+	return &fakeServices{
+		err: status.Error(codes.Unavailable, "service down"),
+		items: []*pb.CartItem{
+			{ProductId: "MUG", Quantity: 2},
+			{ProductId: "TANK", VariantSku: "TANK-M", Quantity: 1},
+		},
+	}
+}
+
+func (f *fakeServices) call(ctx context.Context, method string, slow bool) error {
+	f.mu.Lock()
+	f.calls = append(f.calls, method)
+	f.mu.Unlock()
+	if slow && f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if method == f.fail {
+		return f.err
+	}
+	return nil
+}
+
+// the compensations that ran, in order
+func (f *fakeServices) compensations() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []string
+	for _, c := range f.calls {
+		if slices.Contains(compensationCalls, c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (f *fakeServices) called(method string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Contains(f.calls, method)
+}
+
+func (f *fakeServices) GetCart(ctx context.Context, in *pb.GetCartRequest, opts ...grpc.CallOption) (*pb.Cart, error) {
+	if err := f.call(ctx, "GetCart", true); err != nil {
+		return nil, err
+	}
+	return &pb.Cart{UserId: in.UserId, Items: f.items, Version: 3}, nil
+}
+
+func (f *fakeServices) EmptyCart(ctx context.Context, in *pb.EmptyCartRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	return new(pb.Empty), f.call(ctx, "EmptyCart", false)
+}
+
+func (f *fakeServices) AddItem(ctx context.Context, in *pb.AddItemRequest, opts ...grpc.CallOption) (*pb.AddItemResponse, error) {
+	return new(pb.AddItemResponse), f.call(ctx, "AddItem", false)
+}
+
+func (f *fakeServices) Convert(ctx context.Context, in *pb.CurrencyConversionRequest, opts ...grpc.CallOption) (*pb.Money, error) {
+	if err := f.call(ctx, "Convert", true); err != nil {
+		return nil, err
+	}
+	return &pb.Money{CurrencyCode: in.ToCode, Units: in.From.GetUnits(), Nanos: in.From.GetNanos()}, nil
+}
+
+func (f *fakeServices) SendOrderConfirmation(ctx context.Context, in *pb.SendOrderConfirmationRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	return new(pb.Empty), f.call(ctx, "SendOrderConfirmation", false)
+}
+
+func (f *fakeServices) Reserve(ctx context.Context, in *pb.ReserveRequest, opts ...grpc.CallOption) (*pb.ReserveResponse, error) {
+	if err := f.call(ctx, "Reserve", false); err != nil {
+		return nil, err
+	}
+	return &pb.ReserveResponse{OrderId: in.OrderId}, nil
+}
+
+func (f *fakeServices) Commit(ctx context.Context, in *pb.CommitRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	return new(pb.Empty), f.call(ctx, "Commit", false)
+}
+
+func (f *fakeServices) Release(ctx context.Context, in *pb.ReleaseRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	return new(pb.Empty), f.call(ctx, "Release", false)
+}
+
+func (f *fakeServices) Charge(ctx context.Context, in *pb.ChargeRequest, opts ...grpc.CallOption) (*pb.ChargeResponse, error) {
+	if err := f.call(ctx, "Charge", false); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	f.chargeKeys = append(f.chargeKeys, in.IdempotencyKey)
+	f.mu.Unlock()
+	return &pb.ChargeResponse{TransactionId: "tx-1"}, nil
+}
+
+func (f *fakeServices) Refund(ctx context.Context, in *pb.RefundRequest, opts ...grpc.CallOption) (*pb.RefundResponse, error) {
+	return &pb.RefundResponse{RefundId: "refund-1"}, f.call(ctx, "Refund", false)
+}
+
+func (f *fakeServices) GetProduct(ctx context.Context, in *pb.GetProductRequest, opts ...grpc.CallOption) (*pb.Product, error) {
+	if err := f.call(ctx, "GetProduct", true); err != nil {
+		return nil, err
+	}
+	p := &pb.Product{Id: in.Id, PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 10}}
+	if in.Id == "TANK" {
+		p.Variants = []*pb.ProductVariant{{Sku: "TANK-M"}}
+	}
+	return p, nil
+}
+
+func (f *fakeServices) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest, opts ...grpc.CallOption) (*pb.ValidateAddressResponse, error) {
+	if err := f.call(ctx, "ValidateAddress", false); err != nil {
+		return nil, err
+	}
+	return &pb.ValidateAddressResponse{Valid: true, Normalized: in.Address}, nil
+}
+
+func (f *fakeServices) GetQuote(ctx context.Context, in *pb.GetQuoteRequest, opts ...grpc.CallOption) (*pb.GetQuoteResponse, error) {
+	if err := f.call(ctx, "GetQuote", true); err != nil {
+		return nil, err
+	}
+	cost := &pb.Money{CurrencyCode: "USD", Units: 5}
+	return &pb.GetQuoteResponse{
+		CostUsd: cost,
+		Carrier: "POST",
+		Options: []*pb.ShippingOption{{Id: "post-standard", Carrier: "POST", CostUsd: cost}},
+	}, nil
+}
+
+func (f *fakeServices) ShipOrder(ctx context.Context, in *pb.ShipOrderRequest, opts ...grpc.CallOption) (*pb.ShipOrderResponse, error) {
+	if err := f.call(ctx, "ShipOrder", false); err != nil {
+		return nil, err
+	}
+	return &pb.ShipOrderResponse{TrackingId: "TRACK-1"}, nil
+}
+
+func (f *fakeServices) CancelShipment(ctx context.Context, in *pb.CancelShipmentRequest, opts ...grpc.CallOption) (*pb.Shipment, error) {
+	if err := f.call(ctx, "CancelShipment", false); err != nil {
+		return nil, err
+	}
+	return &pb.Shipment{TrackingId: in.TrackingId, Status: pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED}, nil
+}
+
+// An order store that can't record placed orders
+type failingOrders struct {
+	orderstore.OrderStore
+	failPlaced bool
+}
+
+func (s *failingOrders) Save(ctx context.Context, order *pb.Order) error {
+	if s.failPlaced && order.GetStatus() == pb.OrderStatus_ORDER_STATUS_PLACED {
+		return errors.New("disk full")
+	}
+	return s.OrderStore.Save(ctx, order)
+}
+
+func newTestCheckout(tb testing.TB, f *fakeServices) (*CheckoutService, *failingOrders) {
+	tb.Helper()
+	store, err := orderstore.NewFileOrderStore(filepath.Join(tb.TempDir(), "orders.log"))
+	if err != nil {
+		tb.Fatalf("NewFileOrderStore: %v", err)
+	}
+	tb.Cleanup(func() { store.Close() })
+	orders := &failingOrders{OrderStore: store}
+	return &CheckoutService{
+		CartService:           f,
+		CurrencyService:       f,
+		EmailService:          f,
+		InventoryService:      f,
+		PaymentService:        f,
+		ProductCatalogService: f,
+		ShippingService:       f,
+		Orders:                orders,
+	}, orders
+}
+
+func placeOrderRequest(key string) *pb.PlaceOrderRequest {
+	return &pb.PlaceOrderRequest{
+		UserId:         "alice",
+		UserCurrency:   "USD",
+		Email:          "alice@example.com",
+		IdempotencyKey: key,
+		Address:        &pb.Address{StreetAddress: "1 Main St", City: "Springfield", State: "IL", Country: "US", ZipCode: 62701},
+		CreditCard:     &pb.CreditCardInfo{CreditCardNumber: "4432-8015-6152-0454", CreditCardCvv: 672, CreditCardExpirationYear: 2039, CreditCardExpirationMonth: 1},
+	}
+}
+
+// the status the order of a request was last recorded with
+func recordedStatus(t *testing.T, s *CheckoutService, key string) pb.OrderStatus {
+	t.Helper()
+	order, err := s.Orders.FindByKey(context.Background(), "alice", key)
+	if errors.Is(err, orderstore.ErrNotFound) {
+		return pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
+	}
+	if err != nil {
+		t.Fatalf("FindByKey: %v", err)
+	}
+	return order.GetStatus()
+}
+
+func TestPlaceOrderCompensations(t *testing.T) {
+	const (
+		none    = pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
+		failed  = pb.OrderStatus_ORDER_STATUS_FAILED
+		placed  = pb.OrderStatus_ORDER_STATUS_PLACED
+		shipped = "TRACK-1"
+	)
+	tests := []struct {
+		name string
+		fail string
+		err  error
+		// an order store that can't record the placed order
+		failPlaced    bool
+		code          codes.Code
+		compensations []string
+		status        pb.OrderStatus
+	}{
+		{name: "placed", code: codes.OK, status: placed},
+		{name: "address check", fail: "ValidateAddress", code: codes.Unavailable, status: none},
+		{name: "cart read", fail: "GetCart", code: codes.Unavailable, status: none},
+		{name: "product lookup", fail: "GetProduct", code: codes.Unavailable, status: none},
+		{name: "shipping quote", fail: "GetQuote", code: codes.Unavailable, status: none},
+		{name: "out of stock", fail: "Reserve", err: status.Error(codes.FailedPrecondition, "sold out"), code: codes.FailedPrecondition, status: failed},
+		{name: "reservation", fail: "Reserve", code: codes.Unavailable, status: failed},
+		{name: "cart claim", fail: "EmptyCart", code: codes.Unavailable, compensations: []string{"Release"}, status: failed},
+		{name: "changed cart", fail: "EmptyCart", err: status.Error(codes.Aborted, "version mismatch"), code: codes.Aborted, compensations: []string{"Release"}, status: failed},
+		{name: "declined card", fail: "Charge", err: status.Error(codes.InvalidArgument, "card expired"), code: codes.InvalidArgument, compensations: []string{"AddItem", "AddItem", "Release"}, status: failed},
+		{name: "payment", fail: "Charge", code: codes.Unavailable, compensations: []string{"AddItem", "AddItem", "Release"}, status: failed},
+		{name: "stock commit", fail: "Commit", code: codes.Unavailable, compensations: []string{"Refund", "AddItem", "AddItem", "Release"}, status: failed},
+		{name: "expired reservation", fail: "Commit", err: status.Error(codes.NotFound, "no pending reservation"), code: codes.Aborted, compensations: []string{"Refund", "AddItem", "AddItem", "Release"}, status: failed},
+		{name: "shipping", fail: "ShipOrder", code: codes.Unavailable, compensations: []string{"Refund", "AddItem", "AddItem", "Release"}, status: failed},
+		{name: "placed order record", failPlaced: true, code: codes.Unavailable, compensations: []string{"CancelShipment", "Refund", "AddItem", "AddItem", "Release"}, status: failed},
+		// a confirmation email is not worth undoing an order for
+		{name: "confirmation email", fail: "SendOrderConfirmation", code: codes.OK, status: placed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFakeServices()
+			f.fail = tt.fail
+			if tt.err != nil {
+				f.err = tt.err
+			}
+			s, orders := newTestCheckout(t, f)
+			orders.failPlaced = tt.failPlaced
+
+			out, err := s.PlaceOrder(context.Background(), placeOrderRequest("key-1"))
+			if status.Code(err) != tt.code {
+				t.Fatalf("PlaceOrder = %v, want %s", err, tt.code)
+			}
+			if got := f.compensations(); !slices.Equal(got, tt.compensations) {
+				t.Errorf("compensations = %v, want %v", got, tt.compensations)
+			}
+			if got := recordedStatus(t, s, "key-1"); got != tt.status {
+				t.Errorf("recorded status = %s, want %s", got, tt.status)
+			}
+			if tt.code == codes.OK {
+				if out.GetOrder().GetShippingTrackingId() != shipped {
+					t.Errorf("tracking ID = %q, want %s", out.GetOrder().GetShippingTrackingId(), shipped)
+				}
+				if !f.called("Commit") {
+					t.Error("placed order didn't commit its stock")
+				}
+			}
+		})
+	}
+}
+
+// compensations that fail are retried, and the others still run
+func TestCompensationRetries(t *testing.T) {
+	f := newFakeServices()
+	f.fail = "ShipOrder"
+	s, _ := newTestCheckout(t, f)
+	refunds := 0
+	s.PaymentService = &flakyRefunds{fakeServices: f, failures: 1, refunds: &refunds}
+
+	if _, err := s.PlaceOrder(context.Background(), placeOrderRequest("key-1")); status.Code(err) != codes.Unavailable {
+		t.Fatalf("PlaceOrder = %v, want Unavailable", err)
+	}
+	if refunds != 2 {
+		t.Errorf("refund tried %d times, want 2", refunds)
+	}
+	if got, want := f.compensations(), []string{"AddItem", "AddItem", "Release"}; !slices.Equal(got, want) {
+		t.Errorf("compensations after the refund = %v, want %v", got, want)
+	}
+}
+
+// Refunds that fail a number of times before going through
+type flakyRefunds struct {
+	*fakeServices
+	failures int
+	refunds  *int
+}
+
+func (p *flakyRefunds) Refund(ctx context.Context, in *pb.RefundRequest, opts ...grpc.CallOption) (*pb.RefundResponse, error) {
+	*p.refunds++
+	if *p.refunds <= p.failures {
+		return nil, status.Error(codes.Unavailable, "payment down")
+	}
+	return &pb.RefundResponse{RefundId: "refund-1"}, nil
+}
+
+// an order undone because it couldn't be recorded can be placed again with its key
+func TestRetryAfterUnrecordedOrder(t *testing.T) {
+	f := newFakeServices()
+	s, orders := newTestCheckout(t, f)
+	orders.failPlaced = true
+	if _, err := s.PlaceOrder(context.Background(), placeOrderRequest("key-1")); status.Code(err) != codes.Unavailable {
+		t.Fatalf("PlaceOrder = %v, want Unavailable", err)
+	}
+
+	orders.failPlaced = false
+	out, err := s.PlaceOrder(context.Background(), placeOrderRequest("key-1"))
+	if err != nil {
+		t.Fatalf("PlaceOrder retry: %v", err)
+	}
+	if got := recordedStatus(t, s, "key-1"); got != pb.OrderStatus_ORDER_STATUS_PLACED {
+		t.Fatalf("recorded status = %s, want PLACED", got)
+	}
+
+	// and a repeat of the placed order returns it
+	again, err := s.PlaceOrder(context.Background(), placeOrderRequest("key-1"))
+	if err != nil || again.GetOrder().GetOrderId() != out.GetOrder().GetOrderId() {
+		t.Fatalf("PlaceOrder repeat = %v, %v, want order %s", again.GetOrder().GetOrderId(), err, out.GetOrder().GetOrderId())
+	}
+}
+
+// payment keys are global, the key of a charge is scoped to the user
+func TestChargeKeyPerUser(t *testing.T) {
+	f := newFakeServices()
+	s, _ := newTestCheckout(t, f)
+	for _, user := range []string{"alice", "bob"} {
+		in := placeOrderRequest("key-1")
+		in.UserId = user
+		if _, err := s.PlaceOrder(context.Background(), in); err != nil {
+			t.Fatalf("PlaceOrder of %s: %v", user, err)
+		}
+	}
+	if len(f.chargeKeys) != 2 || f.chargeKeys[0] == f.chargeKeys[1] || slices.Contains(f.chargeKeys, "key-1") {
+		t.Fatalf("charge keys = %q, want one per user", f.chargeKeys)
+	}
+}
+
+// Orders of 6 cart lines over services that each take 5ms. speedup compares
+// the time of an order to that of its slow calls made one after the other.
+func BenchmarkPlaceOrder(b *testing.B) {
+	const delay = 5 * time.Millisecond
+	f := newFakeServices()
+	f.delay = delay
+	f.items = nil
+	for i := range 6 {
+		f.items = append(f.items, &pb.CartItem{ProductId: fmt.Sprintf("P%d", i), Quantity: 1})
+	}
+	s, _ := newTestCheckout(b, f)
+	in := placeOrderRequest("")
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for range b.N {
+		if _, err := s.PlaceOrder(ctx, in); err != nil {
+			b.Fatalf("PlaceOrder: %v", err)
+		}
+	}
+	b.StopTimer()
+
+	slow := 0
+	for _, c := range f.calls {
+		switch c {
+		case "GetCart", "GetProduct", "Convert", "GetQuote":
+			slow++
+		}
+	}
+	sequential := time.Duration(slow/b.N) * delay
+	b.ReportMetric(float64(sequential)/float64(b.Elapsed()/time.Duration(b.N)), "speedup")
+}
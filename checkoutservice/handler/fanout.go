@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"sync"
+)
+
+// Most downstream calls a single order preparation makes at once
+const prepParallelism = 8
+
+// fanOut runs task(ctx, i) for i in [0, n) with at most limit running at once.
+// The first error cancels the context of the other tasks, stops starting new
+// ones and is returned once every started task has finished.
+func fanOut(ctx context.Context, n, limit int, task func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, limit)
+	started := 0
+	for ; started < n; started++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := task(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(started)
+	}
+	wg.Wait()
+	if firstErr == nil && started < n {
+		// canceled by the caller before every task could start
+		return ctx.Err()
+	}
+	return firstErr
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"checkoutservice/orderstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "checkoutservice/proto"
+)
+
+// How long an idempotency key returns the order first placed with it
+const idempotencyWindow = 24 * time.Hour
+
+// keyLocks lets one request at a time place an order with a given idempotency
+// key; a duplicate sent while the first is still running waits for it, then
+// finds its order in the store
+type keyLocks struct {
+	mu   sync.Mutex
+	held map[string]chan struct{}
+}
+
+// lock a key until the returned unlock is called, or give up when ctx is done
+func (l *keyLocks) lock(ctx context.Context, key string) (unlock func(), err error) {
+	for {
+		l.mu.Lock()
+		if l.held == nil {
+			l.held = make(map[string]chan struct{})
+		}
+		done, busy := l.held[key]
+		if !busy {
+			done = make(chan struct{})
+			l.held[key] = done
+			l.mu.Unlock()
+			return func() {
+				l.mu.Lock()
+				delete(l.held, key)
+				l.mu.Unlock()
+				close(done)
+			}, nil
+		}
+		l.mu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// previousOrder returns the result of the order a user already placed with an
+// idempotency key, nil when there is none to return: the key is new, the order
+// failed and may be retried, or it is older than the idempotency window
+func (s *CheckoutService) previousOrder(ctx context.Context, userID, key string) (*pb.PlaceOrderResponse, error) {
+	order, err := s.Orders.FindByKey(ctx, userID, key)
+	if errors.Is(err, orderstore.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Failed to look up order: %+v", err)
+	}
+	if time.Since(order.GetCreatedAt().AsTime()) > idempotencyWindow {
+		return nil, nil
+	}
+	switch order.GetStatus() {
+	case pb.OrderStatus_ORDER_STATUS_PLACED:
+		logger.Printf("Order %s replayed for idempotency key %s", order.GetOrderId(), key)
+		return &pb.PlaceOrderResponse{Order: &pb.OrderResult{
+			OrderId:            order.GetOrderId(),
+			ShippingTrackingId: order.GetShippingTrackingId(),
+			ShippingCost:       order.GetShippingCost(),
+			ShippingAddress:    order.GetShippingAddress(),
+			Items:              order.GetItems(),
+		}}, nil
+	case pb.OrderStatus_ORDER_STATUS_FAILED:
+		return nil, nil
+	default:
+		// only left behind by a crash while the order was being placed
+		return nil, status.Errorf(codes.Aborted, "order %s placed with this idempotency key did not complete", order.GetOrderId())
+	}
+}
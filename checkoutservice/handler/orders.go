@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"pagetoken"
+
+	"checkoutservice/orderstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "checkoutservice/proto"
+)
+
+// Bounds of an order history page
+const (
+	defaultOrdersPageSize = 10
+	maxOrdersPageSize     = 100
+)
+
+// Get Order
+func (s *CheckoutService) GetOrder(ctx context.Context, in *pb.GetOrderRequest) (out *pb.Order, e error) {
+	order, err := s.Orders.Get(ctx, in.OrderId)
+	// another user's order is reported the same as a missing one
+	if errors.Is(err, orderstore.ErrNotFound) || (err == nil && in.UserId != "" && order.UserId != in.UserId) {
+		return nil, status.Errorf(codes.NotFound, "no order with ID %s", in.OrderId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get order: %+v", err)
+	}
+	return order, nil
+}
+
+// List Orders
+func (s *CheckoutService) ListOrders(ctx context.Context, in *pb.ListOrdersRequest) (out *pb.ListOrdersResponse, e error) {
+	if in.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id is required")
+	}
+	pageSize := int(in.PageSize)
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page size: %d", in.PageSize)
+	case pageSize == 0:
+		pageSize = defaultOrdersPageSize
+	case pageSize > maxOrdersPageSize:
+		pageSize = maxOrdersPageSize
+	}
+	offset, err := pagetoken.Decode(in.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page token: %q", in.PageToken)
+	}
+
+	orders, total, err := s.Orders.List(ctx, in.UserId, offset, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list orders: %+v", err)
+	}
+	out = new(pb.ListOrdersResponse)
+	out.Orders = orders
+	out.TotalSize = int32(total)
+	if next := offset + len(orders); next < total {
+		out.NextPageToken = pagetoken.Encode(next)
+	}
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"time"
+)
+
+// How long compensations may take once an order has failed, and how often each is tried
+const (
+	compensationTimeout  = 10 * time.Second
+	compensationAttempts = 3
+)
+
+// An action undoing a completed step of an order
+type compensation struct {
+	name string
+	undo func(ctx context.Context) error
+}
+
+// orderSaga records how to undo each step of an order as it completes. When a
+// later step fails, the recorded compensations run in reverse order, so the
+// user gets back their money, cart and reserved stock before the error is
+// returned.
+type orderSaga struct {
+	orderID       string
+	compensations []compensation
+}
+
+// record how to undo a step that just completed
+func (sg *orderSaga) onFailure(name string, undo func(ctx context.Context) error) {
+	sg.compensations = append(sg.compensations, compensation{name: name, undo: undo})
+}
+
+// Compensate undoes the completed steps, latest first. It runs even when ctx
+// is canceled, since the client giving up must not leave a charge behind.
+// A compensation that keeps failing is logged for manual follow-up and the
+// others still run.
+func (sg *orderSaga) compensate(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+	for i := len(sg.compensations) - 1; i >= 0; i-- {
+		c := sg.compensations[i]
+		var err error
+		for attempt := 1; attempt <= compensationAttempts; attempt++ {
+			if err = c.undo(ctx); err == nil {
+				logger.Printf("Order %s: %s done", sg.orderID, c.name)
+				break
+			}
+			if attempt < compensationAttempts {
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Duration(attempt) * 100 * time.Millisecond):
+				}
+			}
+		}
+		if err != nil {
+			logger.Printf("Order %s: failed to %s, needs manual follow-up: %+v", sg.orderID, c.name, err)
+		}
+	}
+	sg.compensations = nil
+}
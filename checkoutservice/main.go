@@ -2,9 +2,12 @@ package main
 
 import (
 	handler "checkoutservice/handler"
+	"checkoutservice/orderstore"
 	pb "checkoutservice/proto"
 	"fmt"
+	"money"
 	"net"
+	"os"
 	"strconv"
 
 	"github.com/hashicorp/consul/api"
@@ -14,6 +17,12 @@ import (
 const PORT = 50020
 const ADDRESS = "127.0.0.1"
 
+// rounding mode of amounts: half-even (default) or half-up
+const ROUNDING_ENV = "MONEY_ROUNDING"
+
+// Order history log
+const ORDER_STORE_PATH = "data/orders.log"
+
 func GetGrpcConn(consulClient *api.Client, serviceName string, serviceTag string) *grpc.ClientConn {
 	service, _, err := consulClient.Health().Service(serviceName, serviceTag, true, nil)
 	if err != nil {
@@ -71,18 +80,39 @@ func main() {
 		return
 	}
 
+	// rounding of charged amounts, must match the other services
+	if mode := os.Getenv(ROUNDING_ENV); mode != "" {
+		rounding, err := money.ParseRoundingMode(mode)
+		if err != nil {
+			fmt.Println("invalid "+ROUNDING_ENV+":", err)
+			return
+		}
+		money.Rounding = rounding
+	}
+
+	// init order store
+	orders, err_orders := orderstore.NewFileOrderStore(ORDER_STORE_PATH)
+	if err_orders != nil {
+		fmt.Println("order store init error:", err_orders)
+		return
+	}
+	defer orders.Close()
+
 	//-----------------------grpc code--------------------------------
 	// init grpc server
 	grpcServer := grpc.NewServer()
 
-	// invoke other services
+	// invoke other services; the inventory is served by productcatalogservice
+	catalogConn := GetGrpcConn(consulClient, "productcatalogservice", "productcatalogservice")
 	checkoutService := &handler.CheckoutService{
 		CartService:           pb.NewCartServiceClient(GetGrpcConn(consulClient, "cartservice", "cartservice")),
 		CurrencyService:       pb.NewCurrencyServiceClient(GetGrpcConn(consulClient, "currencyservice", "currencyservice")),
 		EmailService:          pb.NewEmailServiceClient(GetGrpcConn(consulClient, "emailservice", "emailservice")),
-		ProductCatalogService: pb.NewProductCatalogServiceClient(GetGrpcConn(consulClient, "productcatalogservice", "productcatalogservice")),
+		InventoryService:      pb.NewInventoryServiceClient(catalogConn),
+		ProductCatalogService: pb.NewProductCatalogServiceClient(catalogConn),
 		PaymentService:        pb.NewPaymentServiceClient(GetGrpcConn(consulClient, "paymentservice", "paymentservice")),
 		ShippingService:       pb.NewShippingServiceClient(GetGrpcConn(consulClient, "shippingservice", "shippingservice")),
+		Orders:                orders,
 	}
 
 	// register grpc service
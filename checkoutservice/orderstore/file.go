@@ -0,0 +1,144 @@
+package orderstore
+
+import (
+	"context"
+	"fmt"
+	"recordlog"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "checkoutservice/proto"
+)
+
+// Orders are kept in memory and every saved order is appended to a record log
+// and fsynced before it is applied, so the order history survives a restart or
+// a crash. The last record of an order wins.
+type fileOrderStore struct {
+	sync.RWMutex
+	log    *recordlog.Log[*pb.Order]
+	orders map[string]*pb.Order
+	// order IDs of each user, oldest first
+	byUser map[string][]string
+	// latest order ID by user and idempotency key
+	byKey map[orderKey]string
+}
+
+// An idempotency key is scoped to the user placing the order
+type orderKey struct {
+	userID string
+	key    string
+}
+
+// Orders are logged as protojson
+var orderCodec = recordlog.Codec[*pb.Order]{
+	Marshal: func(order *pb.Order) ([]byte, error) {
+		return protojson.Marshal(order)
+	},
+	Unmarshal: func(data []byte) (*pb.Order, error) {
+		order := new(pb.Order)
+		return order, protojson.Unmarshal(data, order)
+	},
+}
+
+// Instantiate an OrderStore persisted in the log file at path
+func NewFileOrderStore(path string) (OrderStore, error) {
+	s := &fileOrderStore{
+		orders: make(map[string]*pb.Order),
+		byUser: make(map[string][]string),
+		byKey:  make(map[orderKey]string),
+	}
+	l, err := recordlog.Open(path, recordlog.Config[*pb.Order]{
+		Name:     "order log",
+		Codec:    orderCodec,
+		Apply:    s.apply,
+		Snapshot: s.snapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log = l
+	return s, nil
+}
+
+// Save Order
+func (s *fileOrderStore) Save(ctx context.Context, order *pb.Order) error {
+	if order.GetOrderId() == "" {
+		return fmt.Errorf("order has no id")
+	}
+	order = proto.Clone(order).(*pb.Order)
+
+	s.Lock()
+	defer s.Unlock()
+	return s.log.Append(order)
+}
+
+// Get Order
+func (s *fileOrderStore) Get(ctx context.Context, orderID string) (*pb.Order, error) {
+	s.RLock()
+	defer s.RUnlock()
+	order, ok := s.orders[orderID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(order).(*pb.Order), nil
+}
+
+// Find Order By Idempotency Key
+func (s *fileOrderStore) FindByKey(ctx context.Context, userID, idempotencyKey string) (*pb.Order, error) {
+	s.RLock()
+	defer s.RUnlock()
+	id, ok := s.byKey[orderKey{userID, idempotencyKey}]
+	if !ok || idempotencyKey == "" {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(s.orders[id]).(*pb.Order), nil
+}
+
+// List Orders
+func (s *fileOrderStore) List(ctx context.Context, userID string, offset, limit int) ([]*pb.Order, int, error) {
+	s.RLock()
+	defer s.RUnlock()
+	ids := s.byUser[userID]
+	var out []*pb.Order
+	for i := len(ids) - 1 - offset; i >= 0; i-- {
+		if limit > 0 && len(out) == limit {
+			break
+		}
+		out = append(out, proto.Clone(s.orders[ids[i]]).(*pb.Order))
+	}
+	return out, len(ids), nil
+}
+
+// Close the log file
+func (s *fileOrderStore) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.log.Close()
+}
+
+// apply a saved order to the in-memory index; the caller holds the write lock
+func (s *fileOrderStore) apply(order *pb.Order) error {
+	if _, ok := s.orders[order.GetOrderId()]; !ok {
+		s.byUser[order.GetUserId()] = append(s.byUser[order.GetUserId()], order.GetOrderId())
+	}
+	s.orders[order.GetOrderId()] = order
+	if order.GetIdempotencyKey() != "" {
+		s.byKey[orderKey{order.GetUserId(), order.GetIdempotencyKey()}] = order.GetOrderId()
+	}
+	return nil
+}
+
+// emit one record per order, to compact the log; users' orders oldest first,
+// so that a replay rebuilds the same history order. The caller holds the write lock
+func (s *fileOrderStore) snapshot(emit func(*pb.Order) error) error {
+	for _, ids := range s.byUser {
+		for _, id := range ids {
+			if err := emit(s.orders[id]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package orderstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	pb "checkoutservice/proto"
+)
+
+func openStore(t *testing.T, path string) OrderStore {
+	t.Helper()
+	s, err := NewFileOrderStore(path)
+	if err != nil {
+		t.Fatalf("NewFileOrderStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func reopen(t *testing.T, s OrderStore, path string) OrderStore {
+	t.Helper()
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return openStore(t, path)
+}
+
+func save(t *testing.T, s OrderStore, id, user, key string, status pb.OrderStatus) {
+	t.Helper()
+	order := &pb.Order{OrderId: id, UserId: user, IdempotencyKey: key, Status: status}
+	if err := s.Save(context.Background(), order); err != nil {
+		t.Fatalf("Save(%s): %v", id, err)
+	}
+}
+
+// order IDs and statuses of a user's orders from offset, newest first
+func listed(t *testing.T, s OrderStore, user string, offset, limit int) ([]string, int) {
+	t.Helper()
+	orders, total, err := s.List(context.Background(), user, offset, limit)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	var out []string
+	for _, o := range orders {
+		out = append(out, fmt.Sprintf("%s:%s", o.GetOrderId(), o.GetStatus()))
+	}
+	return out, total
+}
+
+const (
+	pending = pb.OrderStatus_ORDER_STATUS_PENDING
+	placed  = pb.OrderStatus_ORDER_STATUS_PLACED
+	failed  = pb.OrderStatus_ORDER_STATUS_FAILED
+)
+
+// fill a store with a failed order retried under the same key, and orders of another user
+func fill(t *testing.T, s OrderStore) {
+	t.Helper()
+	save(t, s, "o1", "alice", "k1", pending)
+	save(t, s, "o1", "alice", "k1", failed)
+	save(t, s, "o2", "alice", "k1", pending)
+	save(t, s, "o2", "alice", "k1", placed)
+	save(t, s, "o3", "alice", "", placed)
+	save(t, s, "o4", "bob", "k1", placed)
+}
+
+// check the store holds what fill saved
+func check(t *testing.T, s OrderStore) {
+	t.Helper()
+	ctx := context.Background()
+	got, total := listed(t, s, "alice", 0, 0)
+	want := []string{"o3:ORDER_STATUS_PLACED", "o2:ORDER_STATUS_PLACED", "o1:ORDER_STATUS_FAILED"}
+	if !slices.Equal(got, want) || total != 3 {
+		t.Fatalf("List = %v of %d, want %v of 3", got, total, want)
+	}
+	if o, err := s.FindByKey(ctx, "alice", "k1"); err != nil || o.GetOrderId() != "o2" {
+		t.Fatalf("FindByKey(alice, k1) = %s, %v, want o2", o.GetOrderId(), err)
+	}
+	if o, err := s.FindByKey(ctx, "bob", "k1"); err != nil || o.GetOrderId() != "o4" {
+		t.Fatalf("FindByKey(bob, k1) = %s, %v, want o4", o.GetOrderId(), err)
+	}
+	if _, err := s.FindByKey(ctx, "alice", ""); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("FindByKey without a key = %v, want ErrNotFound", err)
+	}
+	if o, err := s.Get(ctx, "o1"); err != nil || o.GetStatus() != failed {
+		t.Fatalf("Get(o1) = %v, %v, want a failed order", o, err)
+	}
+	if _, err := s.Get(ctx, "o9"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get of a missing order = %v, want ErrNotFound", err)
+	}
+}
+
+func TestOrders(t *testing.T) {
+	s := openStore(t, filepath.Join(t.TempDir(), "orders.log"))
+	fill(t, s)
+	check(t, s)
+
+	if err := s.Save(context.Background(), &pb.Order{UserId: "alice"}); err == nil {
+		t.Fatal("Save of an order without an ID succeeded")
+	}
+	// orders handed out are copies
+	o, _ := s.Get(context.Background(), "o1")
+	o.Status = placed
+	check(t, s)
+}
+
+func TestListPages(t *testing.T) {
+	s := openStore(t, filepath.Join(t.TempDir(), "orders.log"))
+	for i := range 5 {
+		save(t, s, fmt.Sprintf("o%d", i), "alice", "", placed)
+	}
+	tests := []struct {
+		offset, limit int
+		want          []string
+	}{
+		{0, 2, []string{"o4", "o3"}},
+		{2, 2, []string{"o2", "o1"}},
+		{4, 2, []string{"o0"}},
+		{5, 2, nil},
+		{9, 2, nil},
+		{1, 0, []string{"o3", "o2", "o1", "o0"}},
+	}
+	for _, tt := range tests {
+		orders, total, err := s.List(context.Background(), "alice", tt.offset, tt.limit)
+		var got []string
+		for _, o := range orders {
+			got = append(got, o.GetOrderId())
+		}
+		if err != nil || total != 5 || !slices.Equal(got, tt.want) {
+			t.Errorf("List(%d, %d) = %v of %d, %v, want %v of 5", tt.offset, tt.limit, got, total, err, tt.want)
+		}
+	}
+	if orders, total, _ := s.List(context.Background(), "nobody", 0, 10); len(orders) != 0 || total != 0 {
+		t.Errorf("List of a user without orders = %d of %d", len(orders), total)
+	}
+}
+
+func TestOrdersSurviveRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.log")
+	s := openStore(t, path)
+	fill(t, s)
+	s = reopen(t, s, path)
+	check(t, s)
+}
+
+func TestOrdersSurviveCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.log")
+	s := openStore(t, path)
+	fill(t, s)
+	if err := s.(*fileOrderStore).log.Compact(); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	check(t, s)
+
+	// and so do the orders saved after it
+	save(t, s, "o5", "bob", "k2", placed)
+	s = reopen(t, s, path)
+	check(t, s)
+	if o, err := s.FindByKey(context.Background(), "bob", "k2"); err != nil || o.GetOrderId() != "o5" {
+		t.Fatalf("FindByKey(bob, k2) = %s, %v, want o5", o.GetOrderId(), err)
+	}
+}
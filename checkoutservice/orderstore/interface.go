@@ -0,0 +1,24 @@
+package orderstore
+
+import (
+	"context"
+	"errors"
+
+	pb "checkoutservice/proto"
+)
+
+// Returned when no order has the requested ID
+var ErrNotFound = errors.New("order not found")
+
+// Order interface
+type OrderStore interface {
+	// Save records a new order or replaces the recorded one with the same ID
+	Save(ctx context.Context, order *pb.Order) error
+	Get(ctx context.Context, orderID string) (*pb.Order, error)
+	// FindByKey returns the latest order a user placed with an idempotency key
+	FindByKey(ctx context.Context, userID, idempotencyKey string) (*pb.Order, error)
+	// List returns up to limit orders of a user from offset, newest first, and
+	// how many orders the user has; limit <= 0 means no limit
+	List(ctx context.Context, userID string, offset, limit int) ([]*pb.Order, int, error)
+	Close() error
+}
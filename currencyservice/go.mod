@@ -6,6 +6,7 @@ require (
 	github.com/hashicorp/consul/api v1.28.2
 	google.golang.org/grpc v1.63.2
 	google.golang.org/protobuf v1.34.0
+	money v0.0.0
 )
 
 require (
@@ -29,3 +30,5 @@ require (
 	golang.org/x/text v0.14.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240227224415-6ceb2ff114de // indirect
 )
+
+replace money => ../money
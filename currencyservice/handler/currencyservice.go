@@ -1,67 +1,106 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	pb "currencyservice/proto"
-	"encoding/json"
-	"fmt"
-	"math"
-	"os"
+	"errors"
+	"log"
+	"money"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type CurrencyService struct{}
+// Log
+var (
+	buf    bytes.Buffer
+	logger = log.New(&buf, "logger: ", log.Lshortfile)
+)
+
+type CurrencyService struct {
+	Rates *RateStore
+	// how converted amounts are rounded to the minor unit of the target currency
+	Rounding money.RoundingMode
+	// defaults to time.Now
+	Clock func() time.Time
+}
 
 // Get currency
 func (s *CurrencyService) GetSupportedCurrencies(ctx context.Context, in *pb.Empty) (out *pb.GetSupportedCurrenciesResponse, e error) {
-	data, err := os.ReadFile("data/currency_conversion.json")
-
+	table, err := s.rateTable(nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to load currency data: %+v", err)
-	}
-
-	currencies := make(map[string]float32)
-	if err := json.Unmarshal(data, &currencies); err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to parse currency data: %+v", err)
+		return nil, err
 	}
-
-	fmt.Printf("currency: %v\n", currencies)
-
 	out = new(pb.GetSupportedCurrenciesResponse)
+	out.CurrencyCodes = table.Codes()
+	return out, nil
+}
 
-	out.CurrencyCodes = make([]string, 0, len(currencies))
-
-	for k := range currencies {
-		out.CurrencyCodes = append(out.CurrencyCodes, k)
+// Get the rate table in effect at a point in time
+func (s *CurrencyService) GetRates(ctx context.Context, in *pb.GetRatesRequest) (out *pb.GetRatesResponse, e error) {
+	table, err := s.rateTable(in.AsOf)
+	if err != nil {
+		return nil, err
 	}
+	out = new(pb.GetRatesResponse)
+	out.BaseCurrency = BASE_CURRENCY
+	out.EffectiveDate = table.EffectiveDate()
+	out.Rates = table.Decimals()
 	return out, nil
 }
 
 // convert
 func (s *CurrencyService) Convert(ctx context.Context, in *pb.CurrencyConversionRequest) (out *pb.Money, e error) {
-	data, err := os.ReadFile("data/currency_conversion.json")
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to load currency data: %+v", err)
+	from := money.From(in.GetFrom())
+	if !money.IsValid(from) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid amount: %d units, %d nanos", from.Units, from.Nanos)
 	}
-	currencies := make(map[string]float64)
-	if err := json.Unmarshal(data, &currencies); err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to parse currency data: %+v", err)
+	table, err := s.rateTable(in.AsOf)
+	if err != nil {
+		return nil, err
 	}
-	fromCurrency, found := currencies[in.From.CurrencyCode]
+	fromRate, found := table.Rate(from.CurrencyCode)
 	if !found {
-		return nil, status.Errorf(codes.InvalidArgument, "Unsupported currency: %s", in.From.CurrencyCode)
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported currency: %s", from.CurrencyCode)
 	}
-	toCurrency, found := currencies[in.ToCode]
+	toRate, found := table.Rate(in.ToCode)
 	if !found {
 		return nil, status.Errorf(codes.InvalidArgument, "Unsupported currency: %s", in.ToCode)
 	}
 
-	out = new(pb.Money)
-	out.CurrencyCode = in.ToCode
-	total := int64(math.Floor(float64(in.From.Units*10^9+int64(in.From.Nanos)) / fromCurrency * toCurrency))
-	out.Units = total / 1e9
-	out.Nanos = int32(total % 1e9)
-	return out, nil
+	// amount / fromRate * toRate, exactly, then rounded to the minor unit of the target currency
+	amount := from.Rat()
+	amount.Quo(amount, fromRate)
+	amount.Mul(amount, toRate)
+	converted, err := money.FromRat(amount, in.ToCode, s.Rounding)
+	if errors.Is(err, money.ErrOverflow) {
+		return nil, status.Errorf(codes.OutOfRange, "Converted amount overflows: %s %s", amount.FloatString(9), in.ToCode)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to convert amount: %v", err)
+	}
+	return &pb.Money{CurrencyCode: converted.CurrencyCode, Units: converted.Units, Nanos: converted.Nanos}, nil
+}
+
+// rate table in effect at asOf, or now when unset; snapshots dated later
+// haven't taken effect yet
+func (s *CurrencyService) rateTable(asOf *timestamppb.Timestamp) (*RateTable, error) {
+	at := time.Now()
+	if s.Clock != nil {
+		at = s.Clock()
+	}
+	if asOf != nil {
+		if err := asOf.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid as_of: %v", err)
+		}
+		at = asOf.AsTime()
+	}
+	table := s.Rates.History().At(at)
+	if table == nil {
+		return nil, status.Errorf(codes.NotFound, "No exchange rates in effect at %s", at.Format(time.RFC3339))
+	}
+	return table, nil
 }
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	pb "currencyservice/proto"
+	"money"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// a service over a base table, and a snapshot from 2024-05-01 that adds GBP
+func newTestService(t *testing.T, rounding money.RoundingMode, now time.Time) *CurrencyService {
+	t.Helper()
+	dir := t.TempDir()
+	writeRates(t, filepath.Join(dir, "base.json"), `{"EUR": 1, "USD": 1.25, "JPY": 150, "KWD": 0.3}`)
+	writeRates(t, filepath.Join(dir, "rates", "2024-05-01.json"), `{"EUR": 1, "USD": 1.10, "JPY": 160, "KWD": 0.3, "GBP": 0.8}`)
+	rates, err := NewRateStore(filepath.Join(dir, "base.json"), filepath.Join(dir, "rates"))
+	if err != nil {
+		t.Fatalf("NewRateStore: %v", err)
+	}
+	return &CurrencyService{Rates: rates, Rounding: rounding, Clock: func() time.Time { return now }}
+}
+
+func convert(s *CurrencyService, from money.Money, to string, asOf *time.Time) (money.Money, error) {
+	in := &pb.CurrencyConversionRequest{
+		From:   &pb.Money{CurrencyCode: from.CurrencyCode, Units: from.Units, Nanos: from.Nanos},
+		ToCode: to,
+	}
+	if asOf != nil {
+		in.AsOf = timestamppb.New(*asOf)
+	}
+	out, err := s.Convert(context.Background(), in)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.From(out), nil
+}
+
+func amount(code string, units int64, nanos int32) money.Money {
+	return money.Money{CurrencyCode: code, Units: units, Nanos: nanos}
+}
+
+func TestConvert(t *testing.T) {
+	before := day("2024-04-30")
+	tests := []struct {
+		name     string
+		from     money.Money
+		to       string
+		rounding money.RoundingMode
+		want     money.Money
+		code     codes.Code
+	}{
+		{name: "to the base", from: amount("USD", 12, 500000000), to: "EUR", want: amount("EUR", 10, 0)},
+		{name: "from the base", from: amount("EUR", 10, 0), to: "USD", want: amount("USD", 12, 500000000)},
+		{name: "same currency", from: amount("USD", 3, 990000000), to: "USD", want: amount("USD", 3, 990000000)},
+		{name: "cross rate", from: amount("USD", 1, 0), to: "JPY", want: amount("JPY", 120, 0)},
+		{name: "no minor unit", from: amount("USD", 0, 10000000), to: "JPY", want: amount("JPY", 1, 0)},
+		{name: "three decimals", from: amount("JPY", 1001, 0), to: "KWD", want: amount("KWD", 2, 2000000)},
+		{name: "below a tie", from: amount("EUR", 0, 10000000), to: "USD", want: amount("USD", 0, 10000000)},
+		{name: "tie half even", from: amount("EUR", 0, 4000000), to: "USD", want: amount("USD", 0, 0)},
+		{name: "tie half up", from: amount("EUR", 0, 4000000), to: "USD", rounding: money.HalfUp, want: amount("USD", 0, 10000000)},
+		{name: "negative tie half even", from: amount("EUR", 0, -4000000), to: "USD", want: amount("USD", 0, 0)},
+		{name: "negative tie half up", from: amount("EUR", 0, -4000000), to: "USD", rounding: money.HalfUp, want: amount("USD", 0, -10000000)},
+		{name: "odd tie half even", from: amount("EUR", 0, 12000000), to: "USD", want: amount("USD", 0, 20000000)},
+		{name: "beyond a tie", from: amount("EUR", 0, 30000000), to: "USD", want: amount("USD", 0, 40000000)},
+		{name: "zero", from: amount("EUR", 0, 0), to: "JPY", want: amount("JPY", 0, 0)},
+		{name: "unknown source", from: amount("XXX", 1, 0), to: "EUR", code: codes.InvalidArgument},
+		{name: "unknown target", from: amount("EUR", 1, 0), to: "XXX", code: codes.InvalidArgument},
+		{name: "snapshot not in effect yet", from: amount("EUR", 1, 0), to: "GBP", code: codes.InvalidArgument},
+		{name: "mixed signs", from: amount("EUR", 1, -1), to: "USD", code: codes.InvalidArgument},
+		{name: "nanos out of range", from: amount("EUR", 1, 1000000000), to: "USD", code: codes.InvalidArgument},
+		{name: "overflow", from: amount("EUR", math.MaxInt64, 0), to: "JPY", code: codes.OutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, tt.rounding, before)
+			got, err := convert(s, tt.from, tt.to, nil)
+			if tt.code != codes.OK {
+				if status.Code(err) != tt.code {
+					t.Fatalf("Convert = %v, %v, want %s", got, err, tt.code)
+				}
+				return
+			}
+			if err != nil || !money.AreEquals(got, tt.want) {
+				t.Fatalf("Convert = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	s := newTestService(t, money.HalfEven, day("2024-04-30"))
+	currencies := []string{"EUR", "USD", "JPY", "KWD"}
+	amounts := []int64{0, 1, 7, 99, 100, 12345, 999999, 100000000}
+	for _, from := range currencies {
+		minor := money.MinorUnits(from)
+		step := int64(math.Pow10(9 - minor))
+		for _, to := range currencies {
+			for _, minorAmount := range amounts {
+				for _, sign := range []int64{1, -1} {
+					// an amount in whole minor units of the source currency
+					nanos := sign * minorAmount * step
+					original := amount(from, nanos/1e9, int32(nanos%1e9))
+					there, err := convert(s, original, to, nil)
+					if err != nil {
+						t.Fatalf("Convert %v to %s: %v", original, to, err)
+					}
+					back, err := convert(s, there, from, nil)
+					if err != nil {
+						t.Fatalf("Convert %v to %s: %v", there, from, err)
+					}
+					// each rounding is off by half a minor unit at most, which
+					// adds up to no more than one minor unit of these currencies
+					diff, err := money.Subtract(back, original)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if d := diff.Units*1e9 + int64(diff.Nanos); d > step || d < -step {
+						t.Errorf("%v -> %v -> %v is off by more than a minor unit", original, there, back)
+					}
+					if from == to && !money.AreEquals(back, original) {
+						t.Errorf("%v -> %v -> %v changed the amount", original, there, back)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestConvertAsOf(t *testing.T) {
+	before, after := day("2024-04-30"), day("2024-05-01").Add(time.Hour)
+	ten := amount("EUR", 10, 0)
+	tests := []struct {
+		name string
+		now  time.Time
+		asOf *time.Time
+		want money.Money
+	}{
+		{name: "now before the snapshot", now: before, want: amount("USD", 12, 500000000)},
+		{name: "now after the snapshot", now: after, want: amount("USD", 11, 0)},
+		{name: "as of before the snapshot", now: after, asOf: &before, want: amount("USD", 12, 500000000)},
+		{name: "as of a future snapshot", now: before, asOf: &after, want: amount("USD", 11, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, money.HalfEven, tt.now)
+			got, err := convert(s, ten, "USD", tt.asOf)
+			if err != nil || !money.AreEquals(got, tt.want) {
+				t.Fatalf("Convert = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	s := newTestService(t, money.HalfEven, before)
+	_, err := s.Convert(context.Background(), &pb.CurrencyConversionRequest{
+		From:   &pb.Money{CurrencyCode: "EUR", Units: 1},
+		ToCode: "USD",
+		AsOf:   &timestamppb.Timestamp{Seconds: math.MaxInt64},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Convert with an invalid as_of = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetSupportedCurrencies(t *testing.T) {
+	for _, tt := range []struct {
+		now  time.Time
+		want []string
+	}{
+		{day("2024-04-30"), []string{"EUR", "JPY", "KWD", "USD"}},
+		{day("2024-05-01"), []string{"EUR", "GBP", "JPY", "KWD", "USD"}},
+	} {
+		s := newTestService(t, money.HalfEven, tt.now)
+		out, err := s.GetSupportedCurrencies(context.Background(), &pb.Empty{})
+		if err != nil || !slices.Equal(out.CurrencyCodes, tt.want) {
+			t.Errorf("GetSupportedCurrencies at %s = %v, %v, want %v", tt.now, out.GetCurrencyCodes(), err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Currency every rate table is relative to
+const BASE_CURRENCY = "EUR"
+
+// Layout of dated snapshot file names, e.g. 2024-05-01.json
+const snapshotDateLayout = "2006-01-02"
+
+// Rates of every supported currency against the base currency, kept as exact rationals
+type RateTable struct {
+	// day the table took effect, zero for the undated base table
+	Effective time.Time
+	rates     map[string]*big.Rat
+	decimals  map[string]string
+}
+
+// parse a rate table, e.g. {"EUR": 1.0, "USD": 1.1305}
+func parseRateTable(data []byte) (*RateTable, error) {
+	raw := make(map[string]json.Number)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	t := &RateTable{
+		rates:    make(map[string]*big.Rat, len(raw)),
+		decimals: make(map[string]string, len(raw)),
+	}
+	for code, n := range raw {
+		r, ok := new(big.Rat).SetString(n.String())
+		if !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate for %s: %s", code, n)
+		}
+		t.rates[code] = r
+		t.decimals[code] = n.String()
+	}
+	if r, ok := t.rates[BASE_CURRENCY]; !ok || r.Cmp(big.NewRat(1, 1)) != 0 {
+		return nil, fmt.Errorf("rate of base currency %s must be 1", BASE_CURRENCY)
+	}
+	return t, nil
+}
+
+// Rate of a currency against the base
+func (t *RateTable) Rate(code string) (*big.Rat, bool) {
+	r, ok := t.rates[code]
+	return r, ok
+}
+
+// Rates as the decimals they were declared with
+func (t *RateTable) Decimals() map[string]string {
+	out := make(map[string]string, len(t.decimals))
+	for code, d := range t.decimals {
+		out[code] = d
+	}
+	return out
+}
+
+// Effective day as YYYY-MM-DD, empty for the base table
+func (t *RateTable) EffectiveDate() string {
+	if t.Effective.IsZero() {
+		return ""
+	}
+	return t.Effective.Format(snapshotDateLayout)
+}
+
+// Supported currency codes in alphabetical order
+func (t *RateTable) Codes() []string {
+	codes := make([]string, 0, len(t.rates))
+	for code := range t.rates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Rate tables ordered by the day they took effect
+type RateHistory struct {
+	tables []*RateTable
+}
+
+// The rate table in effect at t, nil if t precedes every table
+func (h *RateHistory) At(t time.Time) *RateTable {
+	i := sort.Search(len(h.tables), func(i int) bool {
+		return h.tables[i].Effective.After(t)
+	})
+	if i == 0 {
+		return nil
+	}
+	return h.tables[i-1]
+}
+
+// RateStore holds the rate history read from an undated base table and a
+// directory of dated snapshots (YYYY-MM-DD.json, each effective from 00:00 UTC
+// that day), and swaps in a new history when any of the files change.
+// The base table applies before the first snapshot.
+type RateStore struct {
+	basePath    string
+	dir         string
+	history     atomic.Pointer[RateHistory]
+	fingerprint string
+}
+
+// Load the rate history from a base table and a snapshot directory, either may be missing but not both
+func NewRateStore(basePath, dir string) (*RateStore, error) {
+	s := &RateStore{basePath: basePath, dir: dir}
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Current rate history
+func (s *RateStore) History() *RateHistory {
+	return s.history.Load()
+}
+
+// Reload re-reads the files if any changed since the last load and reports whether
+// a new history was installed. Files that fail to parse leave the current history in place.
+func (s *RateStore) Reload() (bool, error) {
+	files, fingerprint, err := s.files()
+	if err != nil {
+		return false, err
+	}
+	if s.history.Load() != nil && fingerprint == s.fingerprint {
+		return false, nil
+	}
+	if len(files) == 0 {
+		return false, fmt.Errorf("no currency data in %s or %s", s.basePath, s.dir)
+	}
+
+	history := &RateHistory{}
+	for _, f := range files {
+		data, err := os.ReadFile(f.path)
+		if err != nil {
+			return false, fmt.Errorf("failed to load currency data: %w", err)
+		}
+		table, err := parseRateTable(data)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse currency data %s: %w", f.path, err)
+		}
+		table.Effective = f.effective
+		history.tables = append(history.tables, table)
+	}
+	s.history.Store(history)
+	s.fingerprint = fingerprint
+	return true, nil
+}
+
+// a rate file and the day it takes effect
+type rateFile struct {
+	path      string
+	effective time.Time
+}
+
+// list the rate files ordered by effective day, with a fingerprint of their names, sizes and modification times
+func (s *RateStore) files() ([]rateFile, string, error) {
+	var files []rateFile
+	var fingerprint strings.Builder
+	if info, err := os.Stat(s.basePath); err == nil {
+		files = append(files, rateFile{path: s.basePath})
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", s.basePath, info.Size(), info.ModTime().UnixNano())
+	} else if !os.IsNotExist(err) {
+		return nil, "", fmt.Errorf("failed to stat currency data: %w", err)
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, "", fmt.Errorf("failed to list currency snapshots: %w", err)
+	}
+	// entries are sorted by name, which for YYYY-MM-DD is by date
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+		effective, err := time.Parse(snapshotDateLayout, strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			logger.Printf("Ignoring currency snapshot with undated name: %s", name)
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to stat currency snapshot: %w", err)
+		}
+		path := filepath.Join(s.dir, name)
+		files = append(files, rateFile{path: path, effective: effective})
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", path, info.Size(), info.ModTime().UnixNano())
+	}
+	return files, fingerprint.String(), nil
+}
+
+// Watch polls the files every interval and reloads them on change until ctx is done
+func (s *RateStore) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := s.Reload()
+			if err != nil {
+				logger.Printf("Keeping current rates: %v", err)
+			} else if reloaded {
+				logger.Printf("Reloaded currency data from %s and %s", s.basePath, s.dir)
+			}
+		}
+	}
+}
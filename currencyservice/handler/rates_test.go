@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// write a rate file, with a modification time that differs from the last write
+func writeRates(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	old, _ := os.Stat(path)
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if old != nil {
+		mtime := old.ModTime().Add(time.Second)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func day(s string) time.Time {
+	d, err := time.Parse(snapshotDateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestParseRateTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+		rates   map[string]string
+	}{
+		{name: "rates", data: `{"EUR": 1.0, "USD": 1.1305, "JPY": 126.40}`, rates: map[string]string{"EUR": "1.0", "USD": "1.1305", "JPY": "126.40"}},
+		{name: "exact decimals", data: `{"EUR": 1, "XAU": 0.000412345678901234}`, rates: map[string]string{"EUR": "1", "XAU": "0.000412345678901234"}},
+		{name: "base only", data: `{"EUR": 1}`, rates: map[string]string{"EUR": "1"}},
+		{name: "no base", data: `{"USD": 1.13}`, wantErr: true},
+		{name: "base not 1", data: `{"EUR": 1.01, "USD": 1.13}`, wantErr: true},
+		{name: "zero rate", data: `{"EUR": 1, "USD": 0}`, wantErr: true},
+		{name: "negative rate", data: `{"EUR": 1, "USD": -1.13}`, wantErr: true},
+		{name: "not a number", data: `{"EUR": 1, "USD": "n/a"}`, wantErr: true},
+		{name: "not an object", data: `[1, 2]`, wantErr: true},
+		{name: "truncated", data: `{"EUR": 1, "USD": 1.1`, wantErr: true},
+		{name: "empty", data: ``, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := parseRateTable([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRateTable(%s) = %v, want an error", tt.data, table.Decimals())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRateTable(%s): %v", tt.data, err)
+			}
+			got := table.Decimals()
+			if len(got) != len(tt.rates) {
+				t.Fatalf("rates = %v, want %v", got, tt.rates)
+			}
+			for code, want := range tt.rates {
+				if got[code] != want {
+					t.Errorf("rate of %s = %s, want %s", code, got[code], want)
+				}
+				if _, ok := table.Rate(code); !ok {
+					t.Errorf("Rate(%s) not found", code)
+				}
+			}
+		})
+	}
+}
+
+func TestRateHistoryAt(t *testing.T) {
+	dir := t.TempDir()
+	writeRates(t, filepath.Join(dir, "base.json"), `{"EUR": 1, "USD": 1.10}`)
+	writeRates(t, filepath.Join(dir, "rates", "2024-03-01.json"), `{"EUR": 1, "USD": 1.20}`)
+	writeRates(t, filepath.Join(dir, "rates", "2024-04-01.json"), `{"EUR": 1, "USD": 1.30}`)
+	writeRates(t, filepath.Join(dir, "rates", "notes.txt"), `ignored`)
+	writeRates(t, filepath.Join(dir, "rates", "latest.json"), `{"EUR": 1, "USD": 9}`)
+	s, err := NewRateStore(filepath.Join(dir, "base.json"), filepath.Join(dir, "rates"))
+	if err != nil {
+		t.Fatalf("NewRateStore: %v", err)
+	}
+
+	tests := []struct {
+		at        time.Time
+		effective string
+		usd       string
+	}{
+		{day("2020-01-01"), "", "1.10"},
+		{day("2024-03-01").Add(-time.Nanosecond), "", "1.10"},
+		{day("2024-03-01"), "2024-03-01", "1.20"},
+		{day("2024-03-31").Add(23 * time.Hour), "2024-03-01", "1.20"},
+		{day("2024-04-01"), "2024-04-01", "1.30"},
+		{day("2030-01-01"), "2024-04-01", "1.30"},
+	}
+	for _, tt := range tests {
+		table := s.History().At(tt.at)
+		if table.EffectiveDate() != tt.effective || table.Decimals()["USD"] != tt.usd {
+			t.Errorf("At(%s) = %s %s, want %s %s", tt.at, table.EffectiveDate(), table.Decimals()["USD"], tt.effective, tt.usd)
+		}
+	}
+}
+
+func TestRateHistoryWithoutBase(t *testing.T) {
+	dir := t.TempDir()
+	writeRates(t, filepath.Join(dir, "rates", "2024-03-01.json"), `{"EUR": 1, "USD": 1.20}`)
+	s, err := NewRateStore(filepath.Join(dir, "missing.json"), filepath.Join(dir, "rates"))
+	if err != nil {
+		t.Fatalf("NewRateStore: %v", err)
+	}
+	if table := s.History().At(day("2024-02-29")); table != nil {
+		t.Fatalf("At before the first snapshot = %s, want nil", table.EffectiveDate())
+	}
+	if table := s.History().At(day("2024-03-02")); table == nil {
+		t.Fatal("At after the first snapshot = nil")
+	}
+
+	if _, err := NewRateStore(filepath.Join(dir, "missing.json"), filepath.Join(dir, "none")); err == nil {
+		t.Fatal("NewRateStore without any file succeeded")
+	}
+}
+
+func TestReloadOnChange(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.json")
+	snapshots := filepath.Join(dir, "rates")
+	writeRates(t, base, `{"EUR": 1, "USD": 1.10}`)
+	s, err := NewRateStore(base, snapshots)
+	if err != nil {
+		t.Fatalf("NewRateStore: %v", err)
+	}
+	usd := func() string {
+		return s.History().At(day("2024-06-01")).Decimals()["USD"]
+	}
+
+	if reloaded, err := s.Reload(); reloaded || err != nil {
+		t.Fatalf("Reload of unchanged files = %v, %v, want false", reloaded, err)
+	}
+
+	writeRates(t, base, `{"EUR": 1, "USD": 1.15}`)
+	if reloaded, err := s.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload of a changed base = %v, %v, want true", reloaded, err)
+	}
+	if got := usd(); got != "1.15" {
+		t.Fatalf("USD after reload = %s, want 1.15", got)
+	}
+
+	writeRates(t, filepath.Join(snapshots, "2024-05-01.json"), `{"EUR": 1, "USD": 1.25}`)
+	if reloaded, err := s.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload of a new snapshot = %v, %v, want true", reloaded, err)
+	}
+	if got := usd(); got != "1.25" {
+		t.Fatalf("USD after a new snapshot = %s, want 1.25", got)
+	}
+
+	// a broken file keeps the rates in place until it is fixed
+	writeRates(t, base, `{"EUR": 1, "USD": `)
+	if reloaded, err := s.Reload(); reloaded || err == nil {
+		t.Fatalf("Reload of a broken base = %v, %v, want an error", reloaded, err)
+	}
+	if got := usd(); got != "1.25" {
+		t.Fatalf("USD after a broken reload = %s, want 1.25", got)
+	}
+	writeRates(t, base, `{"EUR": 1, "USD": 1.12}`)
+	if reloaded, err := s.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload of a fixed base = %v, %v, want true", reloaded, err)
+	}
+
+	if err := os.Remove(filepath.Join(snapshots, "2024-05-01.json")); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := s.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload of a removed snapshot = %v, %v, want true", reloaded, err)
+	}
+	if got := usd(); got != "1.12" {
+		t.Fatalf("USD after removing the snapshot = %s, want 1.12", got)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.json")
+	writeRates(t, base, `{"EUR": 1, "USD": 1.10}`)
+	s, err := NewRateStore(base, filepath.Join(dir, "rates"))
+	if err != nil {
+		t.Fatalf("NewRateStore: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx, time.Millisecond)
+
+	writeRates(t, base, `{"EUR": 1, "USD": 1.50}`)
+	deadline := time.Now().Add(5 * time.Second)
+	for s.History().At(time.Now()).Decimals()["USD"] != "1.50" {
+		if time.Now().After(deadline) {
+			t.Fatal("Watch didn't reload the changed file")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
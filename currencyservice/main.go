@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	handler "currencyservice/handler"
 	pb "currencyservice/proto"
 	"fmt"
+	"money"
 	"net"
+	"os"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"google.golang.org/grpc"
@@ -14,6 +18,15 @@ import (
 const PORT = 50012
 const ADDRESS = "127.0.0.1"
 
+// Currency data and how often it is checked for changes: the base rates, and
+// dated snapshots (YYYY-MM-DD.json) that take over from the day in their name
+const RATES_PATH = "data/currency_conversion.json"
+const RATES_HISTORY_DIR = "data/rates"
+const RATES_RELOAD_INTERVAL = 5 * time.Second
+
+// rounding mode of amounts: half-even (default) or half-up
+const ROUNDING_ENV = "MONEY_ROUNDING"
+
 func main() {
 	ipport := ADDRESS + ":" + strconv.Itoa(PORT)
 	// -------------Register on consul---------------
@@ -42,12 +55,31 @@ func main() {
 		return
 	}
 
+	// load currency data and reload it when the file changes
+	rates, err_rates := handler.NewRateStore(RATES_PATH, RATES_HISTORY_DIR)
+	if err_rates != nil {
+		fmt.Println("currency data init error:", err_rates)
+		return
+	}
+	go rates.Watch(context.Background(), RATES_RELOAD_INTERVAL)
+
+	// rounding of converted amounts, must match the other services
+	rounding := money.HalfEven
+	if mode := os.Getenv(ROUNDING_ENV); mode != "" {
+		parsed, err := money.ParseRoundingMode(mode)
+		if err != nil {
+			fmt.Println("invalid "+ROUNDING_ENV+":", err)
+			return
+		}
+		rounding = parsed
+	}
+
 	//-----------------------grpc code--------------------------------
 	// init grpc server
 	grpcServer := grpc.NewServer()
 
 	// register grpc service
-	pb.RegisterCurrencyServiceServer(grpcServer, new(handler.CurrencyService))
+	pb.RegisterCurrencyServiceServer(grpcServer, &handler.CurrencyService{Rates: rates, Rounding: rounding})
 
 	// start grpc listen
 	listen, err := net.Listen("tcp", ipport)
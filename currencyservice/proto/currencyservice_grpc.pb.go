@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	CurrencyService_GetSupportedCurrencies_FullMethodName = "/microshopping.CurrencyService/GetSupportedCurrencies"
 	CurrencyService_Convert_FullMethodName                = "/microshopping.CurrencyService/Convert"
+	CurrencyService_GetRates_FullMethodName               = "/microshopping.CurrencyService/GetRates"
 )
 
 // CurrencyServiceClient is the client API for CurrencyService service.
@@ -31,6 +32,8 @@ type CurrencyServiceClient interface {
 	GetSupportedCurrencies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetSupportedCurrenciesResponse, error)
 	// convert
 	Convert(ctx context.Context, in *CurrencyConversionRequest, opts ...grpc.CallOption) (*Money, error)
+	// get the rate table in effect at a point in time
+	GetRates(ctx context.Context, in *GetRatesRequest, opts ...grpc.CallOption) (*GetRatesResponse, error)
 }
 
 type currencyServiceClient struct {
@@ -59,6 +62,15 @@ func (c *currencyServiceClient) Convert(ctx context.Context, in *CurrencyConvers
 	return out, nil
 }
 
+func (c *currencyServiceClient) GetRates(ctx context.Context, in *GetRatesRequest, opts ...grpc.CallOption) (*GetRatesResponse, error) {
+	out := new(GetRatesResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_GetRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CurrencyServiceServer is the server API for CurrencyService service.
 // All implementations should embed UnimplementedCurrencyServiceServer
 // for forward compatibility
@@ -67,6 +79,8 @@ type CurrencyServiceServer interface {
 	GetSupportedCurrencies(context.Context, *Empty) (*GetSupportedCurrenciesResponse, error)
 	// convert
 	Convert(context.Context, *CurrencyConversionRequest) (*Money, error)
+	// get the rate table in effect at a point in time
+	GetRates(context.Context, *GetRatesRequest) (*GetRatesResponse, error)
 }
 
 // UnimplementedCurrencyServiceServer should be embedded to have forward compatible implementations.
@@ -79,6 +93,9 @@ func (UnimplementedCurrencyServiceServer) GetSupportedCurrencies(context.Context
 func (UnimplementedCurrencyServiceServer) Convert(context.Context, *CurrencyConversionRequest) (*Money, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
 }
+func (UnimplementedCurrencyServiceServer) GetRates(context.Context, *GetRatesRequest) (*GetRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRates not implemented")
+}
 
 // UnsafeCurrencyServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CurrencyServiceServer will
@@ -127,6 +144,24 @@ func _CurrencyService_Convert_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CurrencyService_GetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).GetRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_GetRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).GetRates(ctx, req.(*GetRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CurrencyService_ServiceDesc is the grpc.ServiceDesc for CurrencyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -142,6 +177,10 @@ var CurrencyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Convert",
 			Handler:    _CurrencyService_Convert_Handler,
 		},
+		{
+			MethodName: "GetRates",
+			Handler:    _CurrencyService_GetRates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/currencyservice.proto",
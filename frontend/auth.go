@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ctxKeyUserID struct{}
+
+// Accounts that can log in, with their bcrypt password hashes by user ID
+type accounts map[string]string
+
+// load the accounts file, a JSON object of user IDs to bcrypt hashes
+func loadAccounts(path string) (accounts, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read accounts")
+	}
+	var out accounts
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, errors.Wrap(err, "parse accounts")
+	}
+	return out, nil
+}
+
+// check the password of an account
+func (a accounts) check(userID, password string) bool {
+	hash, ok := a[userID]
+	if !ok {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// the key the user cookie is signed with, from the environment or random,
+// in which case logins don't survive a restart
+func sessionKey() []byte {
+	if key := os.Getenv(sessionKeyEnv); key != "" {
+		return []byte(key)
+	}
+	log.Warnf("%s is not set, using a random key: logins end when the frontend restarts", sessionKeyEnv)
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalf("Failed to generate a session key: %v", err)
+	}
+	return key
+}
+
+// sign a user ID into a cookie value, "<user id>.<expiry>.<hmac>" with the
+// user ID and hmac base64-encoded
+func signUser(key []byte, userID string, expires time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(userID)) + "." + strconv.FormatInt(expires.Unix(), 10)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(userMAC(key, payload))
+}
+
+// the user ID of a signed cookie value, false when it is forged or expired
+func verifyUser(key []byte, value string, now time.Time) (string, bool) {
+	i := strings.LastIndexByte(value, '.')
+	if i < 0 {
+		return "", false
+	}
+	payload, sig := value[:i], value[i+1:]
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, userMAC(key, payload)) {
+		return "", false
+	}
+	encoded, expiry, found := strings.Cut(payload, ".")
+	if !found {
+		return "", false
+	}
+	expires, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || now.Unix() >= expires {
+		return "", false
+	}
+	userID, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(userID) == 0 {
+		return "", false
+	}
+	return string(userID), true
+}
+
+func userMAC(key []byte, payload string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// set the signed user cookie of a logged-in account
+func (fe *FrontendServer) setUserCookie(w http.ResponseWriter, userID string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieUserID,
+		Value:    signUser(fe.sessionKey, userID, time.Now().Add(cookieMaxAge*time.Second)),
+		MaxAge:   cookieMaxAge,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// put the user of a validly signed user cookie in the request context; a
+// forged or expired cookie is ignored
+func (fe *FrontendServer) ensureUser(next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie(cookieUserID); err == nil {
+			if userID, ok := verifyUser(fe.sessionKey, c.Value, time.Now()); ok {
+				r = r.WithContext(context.WithValue(r.Context(), ctxKeyUserID{}, userID))
+			}
+		}
+		next.ServeHTTP(w, r)
+	}
+}
+
+// the logged-in account, empty for a guest
+func userID(r *http.Request) string {
+	v, _ := r.Context().Value(ctxKeyUserID{}).(string)
+	return v
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"money"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	"frontend/money"
 	pb "frontend/proto"
 )
 
@@ -42,50 +46,230 @@ func init() {
 // Home page
 func (fe *FrontendServer) HomeHandler(ctx *gin.Context) {
 	r := ctx.Request
+	sort, err := productSort(ctx.Query("sort"))
+	if err != nil {
+		renderHTTPError(log, ctx, err, http.StatusBadRequest)
+		return
+	}
 	currencies, err := fe.getCurrencies(r.Context())
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for currencies"), http.StatusInternalServerError)
 		return
 	}
-	products, err := fe.getProducts(r.Context())
+	page, err := fe.getProducts(r.Context(), &pb.ListProductsRequest{
+		Category:  ctx.Query("category"),
+		Sort:      sort,
+		PageSize:  productsPerPage,
+		PageToken: ctx.Query("page"),
+	})
 
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for products"), http.StatusInternalServerError)
 		return
 	}
-	cart, err := fe.getCart(r.Context(), sessionID(r))
+	cart, err := fe.getCart(r.Context(), cartID(r))
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
 		return
 	}
 
-	type productView struct {
-		Item  *pb.Product
-		Price *pb.Money
+	// only the products of the page are converted
+	ps, err := fe.localizeProducts(r, page.GetProducts())
+	if err != nil {
+		renderHTTPError(log, ctx, err, http.StatusInternalServerError)
+		return
+	}
+
+	resultMap := map[string]interface{}{
+		"session_id":      sessionID(r),
+		"request_id":      r.Context().Value(ctxKeyRequestID{}),
+		"user_currency":   currentCurrency(r),
+		"show_currency":   true,
+		"currencies":      currencies,
+		"products":        ps,
+		"category":        ctx.Query("category"),
+		"sort":            ctx.Query("sort"),
+		"facets":          page.GetFacets(),
+		"total_size":      page.GetTotalSize(),
+		"next_page_token": page.GetNextPageToken(),
+		"cart_size":       cartSize(cart),
+		"ad":              fe.chooseAd(r.Context(), []string{}, log),
+	}
+
+	ctx.HTML(http.StatusOK, "home", resultMap)
+
+}
+
+// Search results
+func (fe *FrontendServer) searchHandler(ctx *gin.Context) {
+	r := ctx.Request
+	query := ctx.Query("q")
+	sort, err := productSort(ctx.Query("sort"))
+	if err != nil {
+		renderHTTPError(log, ctx, err, http.StatusBadRequest)
+		return
+	}
+	log.WithField("query", query).Debug("Search products")
+
+	currencies, err := fe.getCurrencies(r.Context())
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for currencies"), http.StatusInternalServerError)
+		return
+	}
+	page, err := fe.searchProducts(r.Context(), &pb.SearchProductsRequest{
+		Query:     query,
+		Category:  ctx.Query("category"),
+		Sort:      sort,
+		PageSize:  productsPerPage,
+		PageToken: ctx.Query("page"),
+	})
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for products"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), cartID(r))
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
+		return
+	}
+	ps, err := fe.localizeProducts(r, page.GetResults())
+	if err != nil {
+		renderHTTPError(log, ctx, err, http.StatusInternalServerError)
+		return
+	}
+
+	resultMap := map[string]interface{}{
+		"session_id":      sessionID(r),
+		"request_id":      r.Context().Value(ctxKeyRequestID{}),
+		"user_currency":   currentCurrency(r),
+		"show_currency":   true,
+		"currencies":      currencies,
+		"query":           query,
+		"products":        ps,
+		"category":        ctx.Query("category"),
+		"sort":            ctx.Query("sort"),
+		"facets":          page.GetFacets(),
+		"total_size":      page.GetTotalSize(),
+		"next_page_token": page.GetNextPageToken(),
+		"cart_size":       cartSize(cart),
+	}
+
+	ctx.HTML(http.StatusOK, "search", resultMap)
+}
+
+// Products of a category and its subcategories, below breadcrumbs to the category
+func (fe *FrontendServer) categoryHandler(ctx *gin.Context) {
+	r := ctx.Request
+	slug := ctx.Param("slug")
+	sort, err := productSort(ctx.Query("sort"))
+	if err != nil {
+		renderHTTPError(log, ctx, err, http.StatusBadRequest)
+		return
+	}
+	log.WithField("category", slug).Debug("Browse category")
+
+	tree, err := fe.getCategories(r.Context())
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot retrieve categories"), http.StatusInternalServerError)
+		return
+	}
+	breadcrumbs := categoryPath(tree, slug)
+	if breadcrumbs == nil {
+		renderHTTPError(log, ctx, errors.Errorf("no category %q", slug), http.StatusNotFound)
+		return
+	}
+	category := breadcrumbs[len(breadcrumbs)-1]
+
+	currencies, err := fe.getCurrencies(r.Context())
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for currencies"), http.StatusInternalServerError)
+		return
+	}
+	page, err := fe.getProducts(r.Context(), &pb.ListProductsRequest{
+		Category:  category.GetSlug(),
+		Sort:      sort,
+		PageSize:  productsPerPage,
+		PageToken: ctx.Query("page"),
+	})
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for products"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), cartID(r))
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
+		return
 	}
+	ps, err := fe.localizeProducts(r, page.GetProducts())
+	if err != nil {
+		renderHTTPError(log, ctx, err, http.StatusInternalServerError)
+		return
+	}
+
+	resultMap := map[string]interface{}{
+		"session_id":      sessionID(r),
+		"request_id":      r.Context().Value(ctxKeyRequestID{}),
+		"user_currency":   currentCurrency(r),
+		"show_currency":   true,
+		"currencies":      currencies,
+		"category":        category,
+		"breadcrumbs":     breadcrumbs,
+		"subcategories":   category.GetChildren(),
+		"products":        ps,
+		"sort":            ctx.Query("sort"),
+		"total_size":      page.GetTotalSize(),
+		"next_page_token": page.GetNextPageToken(),
+		"cart_size":       cartSize(cart),
+	}
+
+	ctx.HTML(http.StatusOK, "category", resultMap)
+}
+
+// categories from the top of the tree down to the one with the slug, nil if there is none
+func categoryPath(tree []*pb.Category, slug string) []*pb.Category {
+	for _, c := range tree {
+		if strings.EqualFold(c.GetSlug(), slug) {
+			return []*pb.Category{c}
+		}
+		if path := categoryPath(c.GetChildren(), slug); path != nil {
+			return append([]*pb.Category{c}, path...)
+		}
+	}
+	return nil
+}
+
+// a product with its price in the user currency
+type productView struct {
+	Item  *pb.Product
+	Price *pb.Money
+}
+
+// convert product prices to the user currency
+func (fe *FrontendServer) localizeProducts(r *http.Request, products []*pb.Product) ([]productView, error) {
 	ps := make([]productView, len(products))
 	for i, p := range products {
 		price, err := fe.convertCurrency(r.Context(), p.GetPriceUsd(), currentCurrency(r))
 		if err != nil {
-			renderHTTPError(log, ctx, errors.Wrapf(err, "Failed currency conversion %s", p.GetId()), http.StatusInternalServerError)
-			return
+			return nil, errors.Wrapf(err, "Failed currency conversion %s", p.GetId())
 		}
 		ps[i] = productView{p, price}
 	}
+	return ps, nil
+}
 
-	resultMap := map[string]interface{}{
-		"session_id":    sessionID(r),
-		"request_id":    r.Context().Value(ctxKeyRequestID{}),
-		"user_currency": currentCurrency(r),
-		"show_currency": true,
-		"currencies":    currencies,
-		"products":      ps,
-		"cart_size":     cartSize(cart),
-		"ad":            fe.chooseAd(r.Context(), []string{}, log),
-	}
-
-	ctx.HTML(http.StatusOK, "home", resultMap)
-
+// sort order of a product listing from its query parameter
+func productSort(s string) (pb.ProductSort, error) {
+	switch s {
+	case "", "relevance":
+		return pb.ProductSort_PRODUCT_SORT_RELEVANCE, nil
+	case "price-asc":
+		return pb.ProductSort_PRODUCT_SORT_PRICE_ASC, nil
+	case "price-desc":
+		return pb.ProductSort_PRODUCT_SORT_PRICE_DESC, nil
+	case "name":
+		return pb.ProductSort_PRODUCT_SORT_NAME, nil
+	}
+	return 0, errors.Errorf("Invalid sort order %q", s)
 }
 
 // Product page
@@ -110,7 +294,7 @@ func (fe *FrontendServer) ProductHandler(ctx *gin.Context) {
 		return
 	}
 
-	cart, err := fe.getCart(r.Context(), sessionID(r))
+	cart, err := fe.getCart(r.Context(), cartID(r))
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
 		return
@@ -122,6 +306,29 @@ func (fe *FrontendServer) ProductHandler(ctx *gin.Context) {
 		return
 	}
 
+	type variantView struct {
+		Item      *pb.ProductVariant
+		Price     *pb.Money
+		Available interface{}
+	}
+	variants := make([]variantView, len(p.GetVariants()))
+	stockKeys := []string{id}
+	if len(variants) > 0 {
+		stockKeys = nil
+	}
+	for i, v := range p.GetVariants() {
+		variants[i].Item = v
+		variants[i].Price = price
+		if v.GetPriceUsd() != nil {
+			variants[i].Price, err = fe.convertCurrency(r.Context(), v.GetPriceUsd(), currentCurrency(r))
+			if err != nil {
+				renderHTTPError(log, ctx, errors.Wrap(err, "Failed currency conversion"), http.StatusInternalServerError)
+				return
+			}
+		}
+		stockKeys = append(stockKeys, v.GetSku())
+	}
+
 	recommendations, err := fe.getRecommendations(r.Context(), sessionID(r), []string{id})
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Failed product recommendation"), http.StatusInternalServerError)
@@ -133,6 +340,18 @@ func (fe *FrontendServer) ProductHandler(ctx *gin.Context) {
 		Price *pb.Money
 	}{p, price}
 
+	// stock status is informational, the page still renders without it
+	var available interface{}
+	if stock, err := fe.getStock(r.Context(), stockKeys); err != nil {
+		log.WithField("id", id).WithField("error", err).Warn("Cannot get stock")
+	} else if len(variants) == 0 {
+		available = stock[0].GetAvailable()
+	} else {
+		for i := range variants {
+			variants[i].Available = stock[i].GetAvailable()
+		}
+	}
+
 	resultMap := map[string]interface{}{
 		"session_id":      sessionID(r),
 		"request_id":      r.Context().Value(ctxKeyRequestID{}),
@@ -141,6 +360,8 @@ func (fe *FrontendServer) ProductHandler(ctx *gin.Context) {
 		"show_currency":   true,
 		"currencies":      currencies,
 		"product":         product,
+		"variants":        variants,
+		"available":       available,
 		"recommendations": recommendations,
 		"cart_size":       cartSize(cart),
 	}
@@ -154,19 +375,24 @@ func (fe *FrontendServer) addToCartHandler(ctx *gin.Context) {
 	w := ctx.Writer
 	quantity, _ := strconv.ParseUint(r.FormValue("quantity"), 10, 32)
 	productID := r.FormValue("product_id")
+	variantSKU := r.FormValue("variant_sku")
 	if productID == "" || quantity == 0 {
 		renderHTTPError(log, ctx, errors.New("Invalid form input"), http.StatusBadRequest)
 		return
 	}
-	log.WithField("product", productID).WithField("quantity", quantity).Debug("Add to cart")
+	log.WithField("product", productID).WithField("variant", variantSKU).WithField("quantity", quantity).Debug("Add to cart")
 
 	p, err := fe.getProduct(r.Context(), productID)
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for products"), http.StatusInternalServerError)
 		return
 	}
+	if _, err := variantPrice(p, variantSKU); err != nil {
+		renderHTTPError(log, ctx, err, http.StatusBadRequest)
+		return
+	}
 
-	if err := fe.insertCart(r.Context(), sessionID(r), p.GetId(), int32(quantity)); err != nil {
+	if err := fe.insertCart(r.Context(), cartID(r), p.GetId(), variantSKU, int32(quantity)); err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Conditional Cart Failure"), http.StatusInternalServerError)
 		return
 	}
@@ -174,6 +400,47 @@ func (fe *FrontendServer) addToCartHandler(ctx *gin.Context) {
 	w.WriteHeader(http.StatusFound)
 }
 
+// Update the quantity of a cart item
+func (fe *FrontendServer) updateCartHandler(ctx *gin.Context) {
+	r := ctx.Request
+	w := ctx.Writer
+	quantity, _ := strconv.ParseUint(r.FormValue("quantity"), 10, 32)
+	productID := r.FormValue("product_id")
+	if productID == "" || quantity == 0 {
+		renderHTTPError(log, ctx, errors.New("Invalid form input"), http.StatusBadRequest)
+		return
+	}
+	variantSKU := r.FormValue("variant_sku")
+	log.WithField("product", productID).WithField("variant", variantSKU).WithField("quantity", quantity).Debug("Update cart")
+
+	if err := fe.updateCartItem(r.Context(), cartID(r), productID, variantSKU, int32(quantity)); err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Failed to update cart"), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("location", "/cart")
+	w.WriteHeader(http.StatusFound)
+}
+
+// Remove an item from the cart
+func (fe *FrontendServer) removeFromCartHandler(ctx *gin.Context) {
+	r := ctx.Request
+	w := ctx.Writer
+	productID := r.FormValue("product_id")
+	if productID == "" {
+		renderHTTPError(log, ctx, errors.New("Invalid form input"), http.StatusBadRequest)
+		return
+	}
+	variantSKU := r.FormValue("variant_sku")
+	log.WithField("product", productID).WithField("variant", variantSKU).Debug("Remove from cart")
+
+	if err := fe.removeCartItem(r.Context(), cartID(r), productID, variantSKU); err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Failed to remove from cart"), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("location", "/cart")
+	w.WriteHeader(http.StatusFound)
+}
+
 // Empty cart
 func (fe *FrontendServer) emptyCartHandler(ctx *gin.Context) {
 	r := ctx.Request
@@ -181,7 +448,7 @@ func (fe *FrontendServer) emptyCartHandler(ctx *gin.Context) {
 
 	log.Debug("Empty cart")
 
-	if err := fe.emptyCart(r.Context(), sessionID(r)); err != nil {
+	if err := fe.emptyCart(r.Context(), cartID(r)); err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Failed to empty cart"), http.StatusInternalServerError)
 		return
 	}
@@ -198,7 +465,7 @@ func (fe *FrontendServer) viewCartHandler(ctx *gin.Context) {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for currencies"), http.StatusInternalServerError)
 		return
 	}
-	cart, err := fe.getCart(r.Context(), sessionID(r))
+	cart, err := fe.getCart(r.Context(), cartID(r))
 	if err != nil {
 		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
 		return
@@ -210,40 +477,74 @@ func (fe *FrontendServer) viewCartHandler(ctx *gin.Context) {
 		return
 	}
 
-	shippingCost, err := fe.getShippingQuote(r.Context(), cart, currentCurrency(r))
-	if err != nil {
-		renderHTTPError(log, ctx, errors.Wrap(err, "Failed to shipping"), http.StatusInternalServerError)
-		return
+	// options depend on the destination, so they are only quoted once the address step gave one
+	address := addressForm(r)
+	var shippingOptions []shippingOptionView
+	if address.GetCountryCode() != "" {
+		shippingOptions, err = fe.getShippingOptions(r.Context(), address, cart, currentCurrency(r))
+		if status.Code(err) == codes.InvalidArgument {
+			renderHTTPError(log, ctx, errors.Wrap(err, "Invalid shipping address"), http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			renderHTTPError(log, ctx, errors.Wrap(err, "Failed to shipping"), http.StatusInternalServerError)
+			return
+		}
+	}
+	// the option picked on the cart page, the cheapest by default
+	shippingCost := toProtoMoney(money.Zero(currentCurrency(r)))
+	var shippingOption *pb.ShippingOption
+	for i, o := range shippingOptions {
+		if i == 0 || o.Option.GetId() == ctx.Query("shipping") {
+			shippingOption, shippingCost = o.Option, o.Price
+		}
 	}
 
 	type cartItemView struct {
 		Item     *pb.Product
+		Variant  *pb.ProductVariant
 		Quantity int32
 		Price    *pb.Money
 	}
 	items := make([]cartItemView, len(cart))
-	totalPrice := &pb.Money{CurrencyCode: currentCurrency(r)}
+	totalPrice := money.Zero(currentCurrency(r))
 	for i, item := range cart {
 		p, err := fe.getProduct(r.Context(), item.GetProductId())
 		if err != nil {
 			renderHTTPError(log, ctx, errors.Wrapf(err, "Cannot search for products #%s", item.GetProductId()), http.StatusInternalServerError)
 			return
 		}
-		price, err := fe.convertCurrency(r.Context(), p.GetPriceUsd(), currentCurrency(r))
+		priceUSD, err := variantPrice(p, item.GetVariantSku())
+		if err != nil {
+			renderHTTPError(log, ctx, err, http.StatusInternalServerError)
+			return
+		}
+		price, err := fe.convertCurrency(r.Context(), priceUSD, currentCurrency(r))
 		if err != nil {
 			renderHTTPError(log, ctx, errors.Wrapf(err, "Cannot search for currencies #%s", item.GetProductId()), http.StatusInternalServerError)
 			return
 		}
 
-		multPrice := money.MultiplySlow(price, uint32(item.GetQuantity()))
+		multPrice, err := money.Multiply(money.From(price), int64(item.GetQuantity()))
+		if err == nil {
+			totalPrice, err = money.Sum(totalPrice, multPrice)
+		}
+		if err != nil {
+			renderHTTPError(log, ctx, errors.Wrapf(err, "Cannot compute price of #%s", item.GetProductId()), http.StatusInternalServerError)
+			return
+		}
 		items[i] = cartItemView{
 			Item:     p,
+			Variant:  variant(p, item.GetVariantSku()),
 			Quantity: item.GetQuantity(),
-			Price:    multPrice,
+			Price:    toProtoMoney(multPrice),
 		}
-		totalPrice = money.Must(money.Sum(totalPrice, multPrice))
 	}
-	totalPrice = money.Must(money.Sum(totalPrice, shippingCost))
+	totalPrice, err = money.Sum(totalPrice, money.From(shippingCost))
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot compute cart total"), http.StatusInternalServerError)
+		return
+	}
 	year := time.Now().Year()
 
 	resultMap := map[string]interface{}{
@@ -254,10 +555,15 @@ func (fe *FrontendServer) viewCartHandler(ctx *gin.Context) {
 		"recommendations":  recommendations,
 		"cart_size":        cartSize(cart),
 		"shipping_cost":    shippingCost,
+		"shipping_address": address,
+		"shipping_options": shippingOptions,
+		"shipping_option":  shippingOption,
 		"show_currency":    true,
-		"total_cost":       totalPrice,
+		"total_cost":       toProtoMoney(totalPrice),
 		"items":            items,
 		"expiration_years": []int{year, year + 1, year + 2, year + 3, year + 4},
+		// sent back by the checkout form, so that submitting it twice places one order
+		"idempotency_key": uuid.NewString(),
 	}
 
 	ctx.HTML(http.StatusOK, "cart", resultMap)
@@ -270,16 +576,13 @@ func (fe *FrontendServer) placeOrderHandler(ctx *gin.Context) {
 	log.Debug("Place order")
 
 	var (
-		email         = r.FormValue("email")
-		streetAddress = r.FormValue("street_address")
-		zipCode, _    = strconv.ParseInt(r.FormValue("zip_code"), 10, 32)
-		city          = r.FormValue("city")
-		state         = r.FormValue("state")
-		country       = r.FormValue("country")
-		ccNumber      = strings.ReplaceAll(r.FormValue("credit_card_number"), "-", "")
-		ccMonth, _    = strconv.ParseInt(r.FormValue("credit_card_expiration_month"), 10, 32)
-		ccYear, _     = strconv.ParseInt(r.FormValue("credit_card_expiration_year"), 10, 32)
-		ccCVV, _      = strconv.ParseInt(r.FormValue("credit_card_cvv"), 10, 32)
+		email      = r.FormValue("email")
+		ccNumber   = strings.ReplaceAll(r.FormValue("credit_card_number"), "-", "")
+		ccMonth, _ = strconv.ParseInt(r.FormValue("credit_card_expiration_month"), 10, 32)
+		ccYear, _  = strconv.ParseInt(r.FormValue("credit_card_expiration_year"), 10, 32)
+		ccCVV, _   = strconv.ParseInt(r.FormValue("credit_card_cvv"), 10, 32)
+		key        = r.FormValue("idempotency_key")
+		shippingID = r.FormValue("shipping_option_id")
 	)
 
 	order, err := fe.checkoutService.PlaceOrder(r.Context(), &pb.PlaceOrderRequest{
@@ -289,17 +592,14 @@ func (fe *FrontendServer) placeOrderHandler(ctx *gin.Context) {
 			CreditCardExpirationMonth: int32(ccMonth),
 			CreditCardExpirationYear:  int32(ccYear),
 			CreditCardCvv:             int32(ccCVV)},
-		UserId:       sessionID(r),
-		UserCurrency: currentCurrency(r),
-		Address: &pb.Address{
-			StreetAddress: streetAddress,
-			City:          city,
-			State:         state,
-			ZipCode:       int32(zipCode),
-			Country:       country},
+		UserId:           cartID(r),
+		UserCurrency:     currentCurrency(r),
+		IdempotencyKey:   key,
+		ShippingOptionId: shippingID,
+		Address:          addressForm(r),
 	})
 	if err != nil {
-		renderHTTPError(log, ctx, errors.Wrap(err, "Failed to place order"), http.StatusInternalServerError)
+		renderHTTPError(log, ctx, errors.Wrap(err, "Failed to place order"), orderErrorStatus(err))
 		return
 	}
 	log.WithField("order", order.GetOrder().GetOrderId()).Info("Place order")
@@ -307,10 +607,16 @@ func (fe *FrontendServer) placeOrderHandler(ctx *gin.Context) {
 	order.GetOrder().GetItems()
 	recommendations, _ := fe.getRecommendations(r.Context(), sessionID(r), nil)
 
-	totalPaid := order.GetOrder().GetShippingCost()
+	totalPaid := money.From(order.GetOrder().GetShippingCost())
 	for _, v := range order.GetOrder().GetItems() {
-		multPrice := money.MultiplySlow(v.GetCost(), uint32(v.GetItem().GetQuantity()))
-		totalPaid = money.Must(money.Sum(totalPaid, multPrice))
+		multPrice, err := money.Multiply(money.From(v.GetCost()), int64(v.GetItem().GetQuantity()))
+		if err == nil {
+			totalPaid, err = money.Sum(totalPaid, multPrice)
+		}
+		if err != nil {
+			renderHTTPError(log, ctx, errors.Wrap(err, "Cannot compute order total"), http.StatusInternalServerError)
+			return
+		}
 	}
 
 	currencies, err := fe.getCurrencies(r.Context())
@@ -326,13 +632,150 @@ func (fe *FrontendServer) placeOrderHandler(ctx *gin.Context) {
 		"show_currency":   false,
 		"currencies":      currencies,
 		"order":           order.GetOrder(),
-		"total_paid":      &totalPaid,
+		"total_paid":      toProtoMoney(totalPaid),
 		"recommendations": recommendations,
 	}
 
 	ctx.HTML(http.StatusOK, "order", resultMap)
 }
 
+// Order history of the signed-in account
+func (fe *FrontendServer) ordersHandler(ctx *gin.Context) {
+	r := ctx.Request
+	log.Debug("View orders")
+
+	// a session ID is no proof of identity, so guests have no order history
+	user := userID(r)
+	if user == "" {
+		renderHTTPError(log, ctx, errors.New("Log in to see your orders"), http.StatusUnauthorized)
+		return
+	}
+	page, err := fe.getOrders(r.Context(), user, ordersPerPage, ctx.Query("page"))
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot retrieve orders"), http.StatusInternalServerError)
+		return
+	}
+	currencies, err := fe.getCurrencies(r.Context())
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), cartID(r))
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
+		return
+	}
+
+	resultMap := map[string]interface{}{
+		"session_id":      sessionID(r),
+		"request_id":      r.Context().Value(ctxKeyRequestID{}),
+		"user_currency":   currentCurrency(r),
+		"show_currency":   false,
+		"currencies":      currencies,
+		"orders":          page.GetOrders(),
+		"total_size":      page.GetTotalSize(),
+		"next_page_token": page.GetNextPageToken(),
+		"cart_size":       cartSize(cart),
+	}
+
+	ctx.HTML(http.StatusOK, "orders", resultMap)
+}
+
+// the shipping address entered in a form or in the query of the cart page
+func addressForm(r *http.Request) *pb.Address {
+	return &pb.Address{
+		AddressLine_1: formValue(r, "address_line_1", "street_address"),
+		AddressLine_2: r.FormValue("address_line_2"),
+		City:          r.FormValue("city"),
+		Region:        formValue(r, "region", "state"),
+		PostalCode:    formValue(r, "postal_code", "zip_code"),
+		CountryCode:   formValue(r, "country_code", "country"),
+	}
+}
+
+// value of a form field, or of its legacy name for forms that still use it
+func formValue(r *http.Request, name, legacy string) string {
+	if v := r.FormValue(name); v != "" {
+		return v
+	}
+	return r.FormValue(legacy)
+}
+
+// Tracking of a shipment, status changes newest first
+func (fe *FrontendServer) trackHandler(ctx *gin.Context) {
+	r := ctx.Request
+	id := ctx.Param("id")
+	log.WithField("tracking_id", id).Debug("Track shipment")
+
+	shipment, err := fe.getShipment(r.Context(), id)
+	if status.Code(err) == codes.NotFound {
+		renderHTTPError(log, ctx, errors.Errorf("no shipment with tracking ID %q", id), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot retrieve shipment"), http.StatusInternalServerError)
+		return
+	}
+	events := slices.Clone(shipment.GetEvents())
+	slices.Reverse(events)
+
+	currencies, err := fe.getCurrencies(r.Context())
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search for currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), cartID(r))
+	if err != nil {
+		renderHTTPError(log, ctx, errors.Wrap(err, "Cannot search to cart"), http.StatusInternalServerError)
+		return
+	}
+
+	resultMap := map[string]interface{}{
+		"session_id":    sessionID(r),
+		"request_id":    r.Context().Value(ctxKeyRequestID{}),
+		"user_currency": currentCurrency(r),
+		"show_currency": false,
+		"currencies":    currencies,
+		"shipment":      shipment,
+		"events":        events,
+		"cart_size":     cartSize(cart),
+	}
+
+	ctx.HTML(http.StatusOK, "track", resultMap)
+}
+
+// Log in, carrying the guest cart over to the account
+func (fe *FrontendServer) loginHandler(ctx *gin.Context) {
+	r := ctx.Request
+	w := ctx.Writer
+	userID := strings.TrimSpace(r.FormValue("user_id"))
+	password := r.FormValue("password")
+	if userID == "" || password == "" {
+		renderHTTPError(log, ctx, errors.New("Invalid form input"), http.StatusBadRequest)
+		return
+	}
+	log.WithField("user", userID).Debug("Log in")
+
+	if !fe.accounts.check(userID, password) {
+		renderHTTPError(log, ctx, errors.New("Invalid user ID or password"), http.StatusUnauthorized)
+		return
+	}
+	// only the guest cart of this browser is merged, never another account's cart
+	if from := sessionID(r); from != "" && from != userID {
+		if err := fe.mergeCarts(r.Context(), from, userID); err != nil {
+			renderHTTPError(log, ctx, errors.Wrap(err, "Failed to merge carts"), http.StatusInternalServerError)
+			return
+		}
+	}
+	fe.setUserCookie(w, userID)
+	referer := r.Header.Get("referer")
+	if referer == "" {
+		referer = "/"
+	}
+	w.Header().Set("Location", referer)
+	w.WriteHeader(http.StatusFound)
+}
+
 // Log out
 func (fe *FrontendServer) logoutHandler(ctx *gin.Context) {
 	r := ctx.Request
@@ -384,6 +827,23 @@ func (fe *FrontendServer) chooseAd(ctx context.Context, ctxKeys []string, log lo
 	return ads[rand.Intn(len(ads))]
 }
 
+// HTTP status of a failed order: the checkout status code tells whether the
+// user has to fix their input, review their cart or just try again later
+func orderErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // Error message
 func renderHTTPError(log logrus.FieldLogger, ctx *gin.Context, err error, code int) {
 	r := ctx.Request
@@ -422,6 +882,14 @@ func sessionID(r *http.Request) string {
 	return ""
 }
 
+// the cart owner: the signed-in account, or the anonymous session
+func cartID(r *http.Request) string {
+	if id := userID(r); id != "" {
+		return id
+	}
+	return sessionID(r)
+}
+
 // cartIDs
 func cartIDs(c []*pb.CartItem) []string {
 	out := make([]string, len(c))
@@ -431,6 +899,34 @@ func cartIDs(c []*pb.CartItem) []string {
 	return out
 }
 
+// variant of a product by sku, nil if it has none
+func variant(p *pb.Product, sku string) *pb.ProductVariant {
+	for _, v := range p.GetVariants() {
+		if v.GetSku() == sku {
+			return v
+		}
+	}
+	return nil
+}
+
+// USD price of a product variant; products with variants can only be bought as one of them
+func variantPrice(p *pb.Product, sku string) (*pb.Money, error) {
+	if sku == "" {
+		if len(p.GetVariants()) > 0 {
+			return nil, errors.Errorf("Product %s requires a variant", p.GetId())
+		}
+		return p.GetPriceUsd(), nil
+	}
+	v := variant(p, sku)
+	if v == nil {
+		return nil, errors.Errorf("Product %s has no variant %s", p.GetId(), sku)
+	}
+	if v.GetPriceUsd() != nil {
+		return v.GetPriceUsd(), nil
+	}
+	return p.GetPriceUsd(), nil
+}
+
 // cart size
 func cartSize(c []*pb.CartItem) int {
 	cartSize := 0
@@ -441,9 +937,14 @@ func cartSize(c []*pb.CartItem) int {
 }
 
 // render currency
-func renderMoney(money *pb.Money) string {
-	currencyLogo := renderCurrencyLogo(money.GetCurrencyCode())
-	return fmt.Sprintf("%s%d.%02d", currencyLogo, money.GetUnits(), money.GetNanos()/10000000)
+func renderMoney(m *pb.Money) string {
+	currencyLogo := renderCurrencyLogo(m.GetCurrencyCode())
+	return currencyLogo + money.Format(money.From(m))
+}
+
+// money amount as a protobuf message
+func toProtoMoney(m money.Money) *pb.Money {
+	return &pb.Money{CurrencyCode: m.CurrencyCode, Units: m.Units, Nanos: m.Nanos}
 }
 
 // render currency logo
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"money"
 	"os"
 	"strconv"
 	"text/template"
@@ -26,6 +27,21 @@ const (
 	cookiePrefix    = "shop_"
 	cookieSessionID = cookiePrefix + "session-id"
 	cookieCurrency  = cookiePrefix + "currency"
+	cookieUserID    = cookiePrefix + "user-id"
+
+	// products per page of the home page and search results
+	productsPerPage = 12
+	// orders per page of the order history
+	ordersPerPage = 10
+
+	// rounding mode of amounts: half-even (default) or half-up
+	roundingEnv = "MONEY_ROUNDING"
+
+	// key the user cookie is signed with
+	sessionKeyEnv = "SESSION_KEY"
+	// accounts that can log in
+	accountsEnv         = "ACCOUNTS_FILE"
+	defaultAccountsPath = "data/accounts.json"
 )
 
 var (
@@ -47,9 +63,13 @@ type FrontendServer struct {
 	cartService           pb.CartServiceClient
 	checkoutService       pb.CheckoutServiceClient
 	currencyService       pb.CurrencyServiceClient
+	inventoryService      pb.InventoryServiceClient
 	productCatalogService pb.ProductCatalogServiceClient
 	recommendationService pb.RecommendationServiceClient
 	shippingService       pb.ShippingServiceClient
+
+	accounts   accounts
+	sessionKey []byte
 }
 
 func GetGrpcConn(consulClient *api.Client, serviceName string, serviceTag string) *grpc.ClientConn {
@@ -105,17 +125,42 @@ func main() {
 		return
 	}
 
+	// the inventory is served by productcatalogservice
+	catalogConn := GetGrpcConn(consulClient, "productcatalogservice", "productcatalogservice")
 	svc := &FrontendServer{
 		adService:             pb.NewAdServiceClient(GetGrpcConn(consulClient, "adservice", "adservice")),
 		cartService:           pb.NewCartServiceClient(GetGrpcConn(consulClient, "cartservice", "cartservice")),
 		checkoutService:       pb.NewCheckoutServiceClient(GetGrpcConn(consulClient, "checkoutservice", "checkoutservice")),
 		currencyService:       pb.NewCurrencyServiceClient(GetGrpcConn(consulClient, "currencyservice", "currencyservice")),
-		productCatalogService: pb.NewProductCatalogServiceClient(GetGrpcConn(consulClient, "productcatalogservice", "productcatalogservice")),
+		inventoryService:      pb.NewInventoryServiceClient(catalogConn),
+		productCatalogService: pb.NewProductCatalogServiceClient(catalogConn),
 		recommendationService: pb.NewRecommendationServiceClient(GetGrpcConn(consulClient, "recommendationservice", "recommendationservice")),
 		shippingService:       pb.NewShippingServiceClient(GetGrpcConn(consulClient, "shippingservice", "shippingservice")),
 	}
 
+	// rounding of displayed amounts, must match the other services
+	if mode := os.Getenv(roundingEnv); mode != "" {
+		rounding, err := money.ParseRoundingMode(mode)
+		if err != nil {
+			log.Fatalf("Invalid %s: %v", roundingEnv, err)
+		}
+		money.Rounding = rounding
+	}
+
+	accountsPath := os.Getenv(accountsEnv)
+	if accountsPath == "" {
+		accountsPath = defaultAccountsPath
+	}
+	accounts, err_accounts := loadAccounts(accountsPath)
+	if err_accounts != nil {
+		fmt.Println("accounts init error:", err_accounts)
+		return
+	}
+	svc.accounts = accounts
+	svc.sessionKey = sessionKey()
+
 	r := gin.Default()
+	r.Use(ginMiddleware(ensureSessionID), ginMiddleware(svc.ensureUser))
 
 	r.FuncMap = template.FuncMap{
 		"renderMoney":        renderMoney,
@@ -128,20 +173,34 @@ func main() {
 
 	// Home page
 	r.GET("/", svc.HomeHandler)
+	// Search results
+	r.GET("/search", svc.searchHandler)
+	// Category page
+	r.GET("/category/:slug", svc.categoryHandler)
 	// Product page
 	r.GET("/product/:id", svc.ProductHandler)
 	// Get cart
 	r.GET("/cart", svc.viewCartHandler)
 	// Add to cart
 	r.POST("/cart", svc.addToCartHandler)
+	// Update cart item quantity
+	r.POST("/cart/update", svc.updateCartHandler)
+	// Remove cart item
+	r.POST("/cart/remove", svc.removeFromCartHandler)
 	// empty cart
 	r.POST("/cart/empty", svc.emptyCartHandler)
 	// setting currency
 	r.POST("/setCurrency", svc.setCurrencyHandler)
+	// Log in
+	r.POST("/login", svc.loginHandler)
 	// Log out
 	r.GET("/logout", svc.logoutHandler)
 	// Checkout
 	r.POST("/cart/checkout", svc.placeOrderHandler)
+	// Order history
+	r.GET("/orders", svc.ordersHandler)
+	// Shipment tracking
+	r.GET("/track/:id", svc.trackHandler)
 
 	if err := r.Run(":8052"); err != nil {
 		log.Fatalf("Failed to start gin: %v", err)
@@ -26,9 +26,19 @@ func (fe *FrontendServer) getCurrencies(ctx context.Context) ([]string, error) {
 	return out, nil
 }
 
-func (fe *FrontendServer) getProducts(ctx context.Context) ([]*pb.Product, error) {
-	resp, err := fe.productCatalogService.ListProducts(ctx, &pb.Empty{})
-	return resp.GetProducts(), err
+func (fe *FrontendServer) getProducts(ctx context.Context, in *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	resp, err := fe.productCatalogService.ListProducts(ctx, in)
+	return resp, err
+}
+
+func (fe *FrontendServer) searchProducts(ctx context.Context, in *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	resp, err := fe.productCatalogService.SearchProducts(ctx, in)
+	return resp, err
+}
+
+func (fe *FrontendServer) getCategories(ctx context.Context) ([]*pb.Category, error) {
+	resp, err := fe.productCatalogService.ListCategories(ctx, &pb.Empty{})
+	return resp.GetCategories(), err
 }
 
 func (fe *FrontendServer) getProduct(ctx context.Context, id string) (*pb.Product, error) {
@@ -36,6 +46,11 @@ func (fe *FrontendServer) getProduct(ctx context.Context, id string) (*pb.Produc
 	return resp, err
 }
 
+func (fe *FrontendServer) getStock(ctx context.Context, stockKeys []string) ([]*pb.StockLevel, error) {
+	resp, err := fe.inventoryService.GetStock(ctx, &pb.GetStockRequest{ProductIds: stockKeys})
+	return resp.GetStock(), err
+}
+
 func (fe *FrontendServer) getCart(ctx context.Context, userID string) ([]*pb.CartItem, error) {
 	resp, err := fe.cartService.GetCart(ctx, &pb.GetCartRequest{UserId: userID})
 	return resp.GetItems(), err
@@ -46,17 +61,47 @@ func (fe *FrontendServer) emptyCart(ctx context.Context, userID string) error {
 	return err
 }
 
-func (fe *FrontendServer) insertCart(ctx context.Context, userID, productID string, quantity int32) error {
+func (fe *FrontendServer) insertCart(ctx context.Context, userID, productID, variantSKU string, quantity int32) error {
 	_, err := fe.cartService.AddItem(ctx, &pb.AddItemRequest{
 		UserId: userID,
 		Item: &pb.CartItem{
-			ProductId: productID,
-			Quantity:  quantity,
+			ProductId:  productID,
+			Quantity:   quantity,
+			VariantSku: variantSKU,
+		},
+	})
+	return err
+}
+
+func (fe *FrontendServer) updateCartItem(ctx context.Context, userID, productID, variantSKU string, quantity int32) error {
+	_, err := fe.cartService.UpdateItemQuantity(ctx, &pb.UpdateItemQuantityRequest{
+		UserId: userID,
+		Item: &pb.CartItem{
+			ProductId:  productID,
+			Quantity:   quantity,
+			VariantSku: variantSKU,
 		},
 	})
 	return err
 }
 
+func (fe *FrontendServer) removeCartItem(ctx context.Context, userID, productID, variantSKU string) error {
+	_, err := fe.cartService.RemoveItem(ctx, &pb.RemoveItemRequest{
+		UserId:     userID,
+		ProductId:  productID,
+		VariantSku: variantSKU,
+	})
+	return err
+}
+
+func (fe *FrontendServer) mergeCarts(ctx context.Context, fromUserID, toUserID string) error {
+	_, err := fe.cartService.MergeCarts(ctx, &pb.MergeCartsRequest{
+		FromUserId: fromUserID,
+		ToUserId:   toUserID,
+	})
+	return err
+}
+
 func (fe *FrontendServer) convertCurrency(ctx context.Context, money *pb.Money, currency string) (*pb.Money, error) {
 	if avoidNoopCurrencyConversionRPC && money.GetCurrencyCode() == currency {
 		return money, nil
@@ -67,16 +112,43 @@ func (fe *FrontendServer) convertCurrency(ctx context.Context, money *pb.Money,
 	})
 }
 
-func (fe *FrontendServer) getShippingQuote(ctx context.Context, items []*pb.CartItem, currency string) (*pb.Money, error) {
+// A shipping option with its cost in the user currency
+type shippingOptionView struct {
+	Option *pb.ShippingOption
+	Price  *pb.Money
+}
+
+func (fe *FrontendServer) getShippingOptions(ctx context.Context, address *pb.Address, items []*pb.CartItem, currency string) ([]shippingOptionView, error) {
 	quote, err := fe.shippingService.GetQuote(ctx, &pb.GetQuoteRequest{
-		Address: nil,
+		Address: address,
 		Items:   items,
 	})
 	if err != nil {
 		return nil, err
 	}
-	localized, err := fe.convertCurrency(ctx, quote.GetCostUsd(), currency)
-	return localized, errors.Wrap(err, "failed to convert currency for shipping cost")
+	options := make([]shippingOptionView, len(quote.GetOptions()))
+	for i, o := range quote.GetOptions() {
+		localized, err := fe.convertCurrency(ctx, o.GetCostUsd(), currency)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert currency for shipping cost")
+		}
+		options[i] = shippingOptionView{Option: o, Price: localized}
+	}
+	return options, nil
+}
+
+func (fe *FrontendServer) getShipment(ctx context.Context, trackingID string) (*pb.Shipment, error) {
+	resp, err := fe.shippingService.GetShipment(ctx, &pb.GetShipmentRequest{TrackingId: trackingID})
+	return resp, err
+}
+
+func (fe *FrontendServer) getOrders(ctx context.Context, userID string, pageSize int32, pageToken string) (*pb.ListOrdersResponse, error) {
+	resp, err := fe.checkoutService.ListOrders(ctx, &pb.ListOrdersRequest{
+		UserId:    userID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	return resp, err
 }
 
 func (fe *FrontendServer) getRecommendations(ctx context.Context, userID string, productIDs []string) ([]*pb.Product, error) {
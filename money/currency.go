@@ -0,0 +1,87 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Number of digits after the decimal separator of each currency (ISO 4217)
+var minorUnits = map[string]int{
+	"AUD": 2, "BGN": 2, "BHD": 3, "BRL": 2, "CAD": 2, "CHF": 2, "CLP": 0,
+	"CNY": 2, "CZK": 2, "DKK": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HRK": 2,
+	"HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "ISK": 0, "JOD": 3, "JPY": 0,
+	"KRW": 0, "KWD": 3, "MXN": 2, "MYR": 2, "NOK": 2, "NZD": 2, "OMR": 3,
+	"PHP": 2, "PLN": 2, "RON": 2, "RUB": 2, "SEK": 2, "SGD": 2, "THB": 2,
+	"TND": 3, "TRY": 2, "USD": 2, "VND": 0, "ZAR": 2,
+}
+
+// Minor units of a currency, 2 when it is unknown
+func MinorUnits(currencyCode string) int {
+	if d, ok := minorUnits[currencyCode]; ok {
+		return d
+	}
+	return 2
+}
+
+// How amounts are rounded to the minor unit of their currency
+type RoundingMode int
+
+const (
+	// ties go to the even neighbour, e.g. 0.125 -> 0.12
+	HalfEven RoundingMode = iota
+	// ties go away from zero, e.g. 0.125 -> 0.13
+	HalfUp
+)
+
+// Rounding mode used by Round, Divide, Percentage and Format. It must be the
+// same in every service so that displayed and charged amounts match.
+var Rounding = HalfEven
+
+// Parse "half-even" or "half-up"
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	switch strings.ToLower(s) {
+	case "half-even", "half_even":
+		return HalfEven, nil
+	case "half-up", "half_up":
+		return HalfUp, nil
+	}
+	return HalfEven, fmt.Errorf("unknown rounding mode %q", s)
+}
+
+// Round an amount to the minor unit of its currency
+func Round(m Money) (Money, error) {
+	return RoundWith(m, Rounding)
+}
+
+// Round an amount to the minor unit of its currency with the given mode
+func RoundWith(m Money, mode RoundingMode) (Money, error) {
+	if !IsValid(m) {
+		return Money{}, ErrInvalidValue
+	}
+	return FromRat(m.Rat(), m.CurrencyCode, mode)
+}
+
+// Format an amount with exactly the minor units of its currency, e.g. 19.99 or 2235
+func Format(m Money) string {
+	digits := MinorUnits(m.CurrencyCode)
+	if r, err := Round(m); err == nil {
+		m = r
+	}
+	units, nanos := m.Units, m.Nanos
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+	}
+	// format magnitudes as unsigned so that the most negative amount survives
+	u := uint64(units)
+	if units < 0 {
+		u = -u
+	}
+	if nanos < 0 {
+		nanos = -nanos
+	}
+	if digits == 0 {
+		return fmt.Sprintf("%s%d", sign, u)
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, u, digits, nanos/int32(pow10(9-digits)))
+}
@@ -0,0 +1,196 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// seed amounts at the edges: zero, the smallest nanos, mixed-sign inputs and
+// the int64 limits
+var fuzzSeeds = []struct {
+	units int64
+	nanos int32
+}{
+	{0, 0},
+	{0, 1},
+	{0, -1},
+	{1, 500000000},
+	{-1, -500000000},
+	{12, 345678901},
+	{0, nanosMax},
+	{0, nanosMin},
+	{1, -1},
+	{-1, 1},
+	{math.MaxInt64, nanosMax},
+	{math.MinInt64, nanosMin},
+	{math.MaxInt64, 0},
+	{math.MinInt64, 0},
+	{math.MaxInt64 / 2, 999999999},
+	{math.MinInt64 / 3, -1},
+}
+
+// exact value of an amount in nanos
+func exactNanos(units int64, nanos int32) *big.Int {
+	return nanosOf(Money{Units: units, Nanos: nanos})
+}
+
+// check a result against the exact value it should hold: same value, so the
+// same sign, and units and nanos that agree in sign
+func checkExact(t *testing.T, got Money, want *big.Int) {
+	t.Helper()
+	if !IsValid(got) {
+		t.Fatalf("result %+v is not valid: units and nanos disagree in sign or nanos are out of range", got)
+	}
+	if v := nanosOf(got); v.Cmp(want) != 0 {
+		t.Fatalf("result %+v = %s nanos, want %s", got, v, want)
+	}
+}
+
+// an exact value that doesn't fit in Money
+func overflows(n *big.Int) bool {
+	units := new(big.Int).Quo(n, big.NewInt(nanosMod))
+	return !units.IsInt64()
+}
+
+func FuzzAdd(f *testing.F) {
+	for _, l := range fuzzSeeds {
+		for _, r := range fuzzSeeds[:6] {
+			f.Add(l.units, l.nanos, r.units, r.nanos)
+		}
+	}
+	f.Fuzz(func(t *testing.T, lu int64, ln int32, ru int64, rn int32) {
+		l := Money{CurrencyCode: "USD", Units: lu, Nanos: ln}
+		r := Money{CurrencyCode: "USD", Units: ru, Nanos: rn}
+		sum, err := Sum(l, r)
+		if !IsValid(l) || !IsValid(r) {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Fatalf("Sum(%+v, %+v) = %+v, %v, want ErrInvalidValue", l, r, sum, err)
+			}
+			return
+		}
+		want := new(big.Int).Add(exactNanos(lu, ln), exactNanos(ru, rn))
+		if overflows(want) {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("Sum(%+v, %+v) = %+v, %v, want ErrOverflow", l, r, sum, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Sum(%+v, %+v): %v", l, r, err)
+		}
+		checkExact(t, sum, want)
+
+		// subtracting back gives the left operand
+		back, err := Subtract(sum, r)
+		if err != nil {
+			t.Fatalf("Subtract(%+v, %+v): %v", sum, r, err)
+		}
+		if !AreEquals(back, l) {
+			t.Fatalf("Sum(%+v, %+v) - right = %+v", l, r, back)
+		}
+	})
+}
+
+func FuzzMultiply(f *testing.F) {
+	for _, m := range fuzzSeeds {
+		for _, n := range []int64{0, 1, -1, 2, -3, 1000, math.MaxInt64, math.MinInt64} {
+			f.Add(m.units, m.nanos, n)
+		}
+	}
+	f.Fuzz(func(t *testing.T, units int64, nanos int32, n int64) {
+		m := Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+		product, err := Multiply(m, n)
+		if !IsValid(m) {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Fatalf("Multiply(%+v, %d) = %+v, %v, want ErrInvalidValue", m, n, product, err)
+			}
+			return
+		}
+		want := new(big.Int).Mul(exactNanos(units, nanos), big.NewInt(n))
+		if overflows(want) {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("Multiply(%+v, %d) = %+v, %v, want ErrOverflow", m, n, product, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Multiply(%+v, %d): %v", m, n, err)
+		}
+		checkExact(t, product, want)
+	})
+}
+
+func FuzzAllocate(f *testing.F) {
+	for _, m := range fuzzSeeds {
+		for _, n := range []int{1, 2, 3, 7, 100} {
+			f.Add(m.units, m.nanos, n, "USD")
+		}
+	}
+	f.Add(int64(10), int32(0), 3, "JPY")
+	f.Add(int64(-10), int32(-1), 3, "KWD")
+	f.Add(int64(1), int32(0), 0, "USD")
+	f.Add(int64(1), int32(0), -2, "USD")
+	f.Fuzz(func(t *testing.T, units int64, nanos int32, n int, currency string) {
+		// keep the number of parts small enough to allocate
+		if n > 10000 {
+			n %= 10000
+		}
+		m := Money{CurrencyCode: currency, Units: units, Nanos: nanos}
+		parts, err := Allocate(m, n)
+		switch {
+		case !IsValid(m):
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Fatalf("Allocate(%+v, %d) = %v, %v, want ErrInvalidValue", m, n, parts, err)
+			}
+			return
+		case n <= 0:
+			if !errors.Is(err, ErrInvalidDivisor) {
+				t.Fatalf("Allocate(%+v, %d) = %v, %v, want ErrInvalidDivisor", m, n, parts, err)
+			}
+			return
+		case err != nil:
+			t.Fatalf("Allocate(%+v, %d): %v", m, n, err)
+		}
+		if len(parts) != n {
+			t.Fatalf("Allocate(%+v, %d) returned %d parts", m, n, len(parts))
+		}
+
+		total := exactNanos(units, nanos)
+		step := big.NewInt(pow10(9 - MinorUnits(currency)))
+		sum := new(big.Int)
+		var smallest, largest *big.Int
+		for i, p := range parts {
+			if !IsValid(p) || p.CurrencyCode != currency {
+				t.Fatalf("part %d of Allocate(%+v, %d) = %+v", i, m, n, p)
+			}
+			v := nanosOf(p)
+			// no part has the opposite sign of the amount
+			if v.Sign()*total.Sign() < 0 {
+				t.Fatalf("part %d of Allocate(%+v, %d) = %+v has the wrong sign", i, m, n, p)
+			}
+			sum.Add(sum, v)
+			// the remainder below one minor unit goes to the first part, the others are whole minor units
+			if i > 0 {
+				if new(big.Int).Rem(v, step).Sign() != 0 {
+					t.Fatalf("part %d of Allocate(%+v, %d) = %+v is not a whole minor unit", i, m, n, p)
+				}
+				if smallest == nil || v.CmpAbs(smallest) < 0 {
+					smallest = v
+				}
+				if largest == nil || v.CmpAbs(largest) > 0 {
+					largest = v
+				}
+			}
+		}
+		if sum.Cmp(total) != 0 {
+			t.Fatalf("parts of Allocate(%+v, %d) add up to %s nanos, want %s", m, n, sum, total)
+		}
+		if smallest != nil {
+			if spread := new(big.Int).Sub(new(big.Int).Abs(largest), new(big.Int).Abs(smallest)); spread.Cmp(step) > 0 {
+				t.Fatalf("parts of Allocate(%+v, %d) differ by %s nanos, more than a minor unit", m, n, spread)
+			}
+		}
+	})
+}
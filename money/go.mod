@@ -0,0 +1,3 @@
+module money
+
+go 1.22.0
@@ -0,0 +1,252 @@
+// Package money implements exact arithmetic on amounts expressed, like the
+// Money protobuf message of every service, as whole units plus nanos (10^-9 units).
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+const (
+	nanosMin = -999999999
+	nanosMax = +999999999
+	nanosMod = 1000000000
+)
+
+var (
+	ErrInvalidValue        = errors.New("The specified currency is invalid")
+	ErrMismatchingCurrency = errors.New("No currency code")
+	ErrOverflow            = errors.New("Amount overflows")
+	ErrInvalidDivisor      = errors.New("Divisor must be positive")
+)
+
+// An amount of a currency, e.g. $-1.75 is {"USD", -1, -750000000}
+type Money struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+// Proto is satisfied by the generated Money message of every service
+type Proto interface {
+	GetCurrencyCode() string
+	GetUnits() int64
+	GetNanos() int32
+}
+
+// Copy a Money message
+func From(m Proto) Money {
+	return Money{CurrencyCode: m.GetCurrencyCode(), Units: m.GetUnits(), Nanos: m.GetNanos()}
+}
+
+// Zero amount of a currency
+func Zero(currencyCode string) Money {
+	return Money{CurrencyCode: currencyCode}
+}
+
+func IsValid(m Money) bool {
+	return signMatches(m) && validNanos(m.Nanos)
+}
+
+func signMatches(m Money) bool {
+	return m.Nanos == 0 || m.Units == 0 || (m.Nanos < 0) == (m.Units < 0)
+}
+
+func validNanos(nanos int32) bool { return nanosMin <= nanos && nanos <= nanosMax }
+
+func IsZero(m Money) bool { return m.Units == 0 && m.Nanos == 0 }
+
+func IsPositive(m Money) bool {
+	return IsValid(m) && (m.Units > 0 || (m.Units == 0 && m.Nanos > 0))
+}
+
+func IsNegative(m Money) bool {
+	return IsValid(m) && (m.Units < 0 || (m.Units == 0 && m.Nanos < 0))
+}
+
+func AreSameCurrency(l, r Money) bool {
+	return l.CurrencyCode == r.CurrencyCode && l.CurrencyCode != ""
+}
+
+func AreEquals(l, r Money) bool {
+	return l.CurrencyCode == r.CurrencyCode && l.Units == r.Units && l.Nanos == r.Nanos
+}
+
+// Negate fails only for the one amount without a positive counterpart
+func Negate(m Money) (Money, error) {
+	if !IsValid(m) {
+		return Money{}, ErrInvalidValue
+	}
+	if m.Units == math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+	return Money{CurrencyCode: m.CurrencyCode, Units: -m.Units, Nanos: -m.Nanos}, nil
+}
+
+// Compare returns -1, 0 or +1 as l is less than, equal to or greater than r
+func Compare(l, r Money) (int, error) {
+	if err := check(l, r); err != nil {
+		return 0, err
+	}
+	if l.Units != r.Units {
+		if l.Units < r.Units {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	if l.Nanos != r.Nanos {
+		if l.Nanos < r.Nanos {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// sum
+func Sum(l, r Money) (Money, error) {
+	if err := check(l, r); err != nil {
+		return Money{}, err
+	}
+	return fromNanos(new(big.Int).Add(nanosOf(l), nanosOf(r)), l.CurrencyCode)
+}
+
+// Subtract r from l
+func Subtract(l, r Money) (Money, error) {
+	if err := check(l, r); err != nil {
+		return Money{}, err
+	}
+	return fromNanos(new(big.Int).Sub(nanosOf(l), nanosOf(r)), l.CurrencyCode)
+}
+
+// Multiply an amount by a quantity
+func Multiply(m Money, n int64) (Money, error) {
+	if !IsValid(m) {
+		return Money{}, ErrInvalidValue
+	}
+	return fromNanos(new(big.Int).Mul(nanosOf(m), big.NewInt(n)), m.CurrencyCode)
+}
+
+// Divide an amount into n, rounding the quotient to the minor unit of its
+// currency; use Allocate to split an amount without losing anything
+func Divide(m Money, n int64) (Money, error) {
+	if !IsValid(m) {
+		return Money{}, ErrInvalidValue
+	}
+	if n <= 0 {
+		return Money{}, ErrInvalidDivisor
+	}
+	r := new(big.Rat).SetFrac(nanosOf(m), new(big.Int).Mul(big.NewInt(n), big.NewInt(nanosMod)))
+	return FromRat(r, m.CurrencyCode, Rounding)
+}
+
+// Allocate splits an amount into n parts that add up to it exactly. Parts are
+// multiples of the minor unit of the currency when the amount is, and differ by
+// at most one minor unit; the larger parts come first.
+func Allocate(m Money, n int) ([]Money, error) {
+	if !IsValid(m) {
+		return nil, ErrInvalidValue
+	}
+	if n <= 0 {
+		return nil, ErrInvalidDivisor
+	}
+	step := big.NewInt(pow10(9 - MinorUnits(m.CurrencyCode)))
+	total := nanosOf(m)
+	// split whole minor units, then whatever is left below one minor unit
+	steps, rest := new(big.Int).QuoRem(total, step, new(big.Int))
+	share, extra := new(big.Int).QuoRem(steps, big.NewInt(int64(n)), new(big.Int))
+	sign := int64(total.Sign())
+
+	out := make([]Money, n)
+	for i := range out {
+		part := new(big.Int).Mul(share, step)
+		if int64(i) < sign*extra.Int64() {
+			part.Add(part, new(big.Int).Mul(big.NewInt(sign), step))
+		}
+		if i == 0 {
+			part.Add(part, rest)
+		}
+		p, err := fromNanos(part, m.CurrencyCode)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = p
+	}
+	return out, nil
+}
+
+// Percentage of an amount in basis points (1/100 of a percent, so 1250 is 12.5%),
+// rounded to the minor unit of its currency
+func Percentage(m Money, basisPoints int64) (Money, error) {
+	if !IsValid(m) {
+		return Money{}, ErrInvalidValue
+	}
+	r := new(big.Rat).SetFrac(new(big.Int).Mul(nanosOf(m), big.NewInt(basisPoints)), big.NewInt(10000*nanosMod))
+	return FromRat(r, m.CurrencyCode, Rounding)
+}
+
+// Exact value of an amount in units
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(nanosOf(m), big.NewInt(nanosMod))
+}
+
+// FromRat rounds a value in units to the minor unit of a currency
+func FromRat(r *big.Rat, currencyCode string, mode RoundingMode) (Money, error) {
+	digits := MinorUnits(currencyCode)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt64(pow10(digits)))
+	minor := roundToInt(scaled, mode)
+	return fromNanos(minor.Mul(minor, big.NewInt(pow10(9-digits))), currencyCode)
+}
+
+// both operands valid and in the same currency
+func check(l, r Money) error {
+	if !IsValid(l) || !IsValid(r) {
+		return ErrInvalidValue
+	}
+	if l.CurrencyCode != r.CurrencyCode {
+		return ErrMismatchingCurrency
+	}
+	return nil
+}
+
+// amount as a number of nanos
+func nanosOf(m Money) *big.Int {
+	n := new(big.Int).Mul(big.NewInt(m.Units), big.NewInt(nanosMod))
+	return n.Add(n, big.NewInt(int64(m.Nanos)))
+}
+
+// amount of a number of nanos, units and nanos get the same sign
+func fromNanos(n *big.Int, currencyCode string) (Money, error) {
+	units, nanos := new(big.Int).QuoRem(n, big.NewInt(nanosMod), new(big.Int))
+	if !units.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+	return Money{CurrencyCode: currencyCode, Units: units.Int64(), Nanos: int32(nanos.Int64())}, nil
+}
+
+// round a rational to the nearest integer, resolving ties with mode
+func roundToInt(r *big.Rat, mode RoundingMode) *big.Int {
+	// truncated quotient and remainder carry the sign of the numerator
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	switch twice.Cmp(r.Denom()) {
+	case 1:
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	case 0:
+		if mode == HalfUp || q.Bit(0) == 1 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	}
+	return q
+}
+
+// 10^n for small n
+func pow10(n int) int64 {
+	p := int64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
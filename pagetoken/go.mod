@@ -0,0 +1,3 @@
+module pagetoken
+
+go 1.22.0
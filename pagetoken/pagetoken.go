@@ -0,0 +1,34 @@
+// Package pagetoken implements the page tokens of the services' List and
+// Search RPCs. Tokens are opaque to clients; they carry the offset of the
+// page in the full result.
+package pagetoken
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+// Encode the token of the page starting at offset
+func Encode(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// Decode the offset of a page token, 0 for the first page
+func Decode(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := strconv.Atoi(string(data))
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	return offset, nil
+}
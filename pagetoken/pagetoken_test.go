@@ -0,0 +1,36 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, offset := range []int{0, 1, 10, 12345} {
+		got, err := Decode(Encode(offset))
+		if err != nil || got != offset {
+			t.Errorf("Decode(Encode(%d)) = %d, %v", offset, got, err)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		token string
+		want  int
+		ok    bool
+	}{
+		{"", 0, true},
+		{Encode(20), 20, true},
+		{"not base64!", 0, false},
+		{base64.RawURLEncoding.EncodeToString([]byte("ten")), 0, false},
+		{base64.RawURLEncoding.EncodeToString([]byte("-1")), 0, false},
+		{"MTA=", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := Decode(tt.token)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("Decode(%q) = %d, %v, want %d, ok %v", tt.token, got, err, tt.want, tt.ok)
+		}
+	}
+}
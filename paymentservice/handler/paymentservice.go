@@ -5,11 +5,14 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"sync"
+	"time"
 
 	creditcard "github.com/durango/go-credit-card"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	pb "paymentservice/proto"
 )
@@ -20,7 +23,35 @@ var (
 	logger = log.New(&buf, "logger: ", log.Lshortfile)
 )
 
-type PaymentService struct{}
+// How long an idempotency key returns its charge, and how long a charge can be refunded
+const (
+	keyTTL         = 24 * time.Hour
+	transactionTTL = 7 * 24 * time.Hour
+)
+
+// How often expired keys and charges are dropped
+const pruneInterval = time.Minute
+
+// PaymentService keeps the charges it made so that they can be refunded,
+// and the idempotency keys they were made with. Both expire, and are dropped
+// by the first charge after each prune interval.
+type PaymentService struct {
+	// defaults to time.Now
+	Clock func() time.Time
+
+	mu           sync.Mutex
+	transactions map[string]*transaction
+	// transaction ID by idempotency key
+	keys     map[string]string
+	prunedAt time.Time
+}
+
+// A charge and its refund, if any
+type transaction struct {
+	amount    *pb.Money
+	refundID  string
+	chargedAt time.Time
+}
 
 // Payment service
 func (s *PaymentService) Charge(ctx context.Context, in *pb.ChargeRequest) (out *pb.ChargeResponse, e error) {
@@ -37,6 +68,86 @@ func (s *PaymentService) Charge(ctx context.Context, in *pb.ChargeRequest) (out
 
 	logger.Printf(`Transaction processing: %s, Amount: %s%d.%d`, in.CreditCard.CreditCardNumber, in.Amount.CurrencyCode, in.Amount.Units, in.Amount.Nanos)
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.transactions == nil {
+		s.transactions = make(map[string]*transaction)
+		s.keys = make(map[string]string)
+	}
+	now := s.now()
+	s.prune(now)
+	// a refunded charge, e.g. of an order that failed, is charged anew
+	if tx, txID, ok := s.keyed(in.IdempotencyKey, now); ok && tx.refundID == "" {
+		if !proto.Equal(tx.amount, in.Amount) {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key %s was used for another amount", in.IdempotencyKey)
+		}
+		logger.Printf("Transaction %s replayed for idempotency key %s", txID, in.IdempotencyKey)
+		out.TransactionId = txID
+		return out, nil
+	}
+
 	out.TransactionId = uuid.NewString()
+	s.transactions[out.TransactionId] = &transaction{amount: in.Amount, chargedAt: now}
+	if in.IdempotencyKey != "" {
+		s.keys[in.IdempotencyKey] = out.TransactionId
+	}
 	return out, nil
 }
+
+// Refund a charge
+func (s *PaymentService) Refund(ctx context.Context, in *pb.RefundRequest) (out *pb.RefundResponse, e error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx, ok := s.transactions[in.TransactionId]
+	if !ok || !s.now().Before(tx.chargedAt.Add(transactionTTL)) {
+		return nil, status.Errorf(codes.NotFound, "no transaction with ID %s", in.TransactionId)
+	}
+	if tx.refundID == "" {
+		tx.refundID = uuid.NewString()
+		logger.Printf(`Refund processing: %s, Amount: %s%d.%d`, in.TransactionId, tx.amount.GetCurrencyCode(), tx.amount.GetUnits(), tx.amount.GetNanos())
+	}
+	return &pb.RefundResponse{RefundId: tx.refundID}, nil
+}
+
+func (s *PaymentService) now() time.Time {
+	if s.Clock != nil {
+		return s.Clock()
+	}
+	return time.Now()
+}
+
+// The helpers below expect the caller to hold the lock.
+
+// the charge made with an idempotency key that hasn't expired
+func (s *PaymentService) keyed(key string, now time.Time) (*transaction, string, bool) {
+	if key == "" {
+		return nil, "", false
+	}
+	txID, ok := s.keys[key]
+	if !ok {
+		return nil, "", false
+	}
+	tx, ok := s.transactions[txID]
+	if !ok || !now.Before(tx.chargedAt.Add(keyTTL)) {
+		return nil, "", false
+	}
+	return tx, txID, true
+}
+
+// drop expired keys and charges, at most once per prune interval
+func (s *PaymentService) prune(now time.Time) {
+	if now.Sub(s.prunedAt) < pruneInterval {
+		return
+	}
+	s.prunedAt = now
+	for key, txID := range s.keys {
+		if tx, ok := s.transactions[txID]; !ok || !now.Before(tx.chargedAt.Add(keyTTL)) {
+			delete(s.keys, key)
+		}
+	}
+	for txID, tx := range s.transactions {
+		if !now.Before(tx.chargedAt.Add(transactionTTL)) {
+			delete(s.transactions, txID)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "paymentservice/proto"
+)
+
+func chargeRequest(key string, units int64) *pb.ChargeRequest {
+	return &pb.ChargeRequest{
+		Amount:         &pb.Money{CurrencyCode: "USD", Units: units},
+		CreditCard:     &pb.CreditCardInfo{CreditCardNumber: "4432801561520454", CreditCardCvv: 672, CreditCardExpirationYear: 2039, CreditCardExpirationMonth: 1},
+		IdempotencyKey: key,
+	}
+}
+
+func TestChargeIdempotency(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	s := &PaymentService{Clock: func() time.Time { return now }}
+	charge := func(key string, units int64) (string, codes.Code) {
+		t.Helper()
+		out, err := s.Charge(ctx, chargeRequest(key, units))
+		return out.GetTransactionId(), status.Code(err)
+	}
+
+	first, code := charge("k1", 10)
+	if code != codes.OK {
+		t.Fatalf("Charge = %s", code)
+	}
+	if again, code := charge("k1", 10); again != first || code != codes.OK {
+		t.Fatalf("repeated Charge = %s, %s, want %s", again, code, first)
+	}
+	if _, code := charge("k1", 11); code != codes.InvalidArgument {
+		t.Fatalf("Charge of another amount with the key = %s, want InvalidArgument", code)
+	}
+	if other, _ := charge("k2", 10); other == first {
+		t.Fatal("Charge with another key replayed the first charge")
+	}
+	if a, _ := charge("", 10); a == first {
+		t.Fatal("Charge without a key replayed a charge")
+	}
+
+	// a refunded charge is charged again, for whatever amount
+	if _, err := s.Refund(ctx, &pb.RefundRequest{TransactionId: first}); err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+	retry, code := charge("k1", 12)
+	if code != codes.OK || retry == first {
+		t.Fatalf("Charge after a refund = %s, %s, want a new charge", retry, code)
+	}
+	if again, _ := charge("k1", 12); again != retry {
+		t.Fatalf("repeated Charge after a refund = %s, want %s", again, retry)
+	}
+
+	// keys expire before their charges
+	now = now.Add(keyTTL)
+	if later, _ := charge("k1", 12); later == retry {
+		t.Fatal("expired key replayed its charge")
+	}
+	if _, err := s.Refund(ctx, &pb.RefundRequest{TransactionId: retry}); err != nil {
+		t.Fatalf("Refund of a charge with an expired key: %v", err)
+	}
+	now = now.Add(transactionTTL)
+	if _, err := s.Refund(ctx, &pb.RefundRequest{TransactionId: retry}); status.Code(err) != codes.NotFound {
+		t.Fatalf("Refund of an expired charge = %v, want NotFound", err)
+	}
+	charge("k3", 1)
+	if len(s.keys) != 1 || len(s.transactions) != 1 {
+		t.Fatalf("after pruning %d keys and %d charges are left, want 1 and 1", len(s.keys), len(s.transactions))
+	}
+}
@@ -5,6 +5,8 @@ go 1.22.0
 require (
 	google.golang.org/grpc v1.63.2
 	google.golang.org/protobuf v1.34.1
+	money v0.0.0
+	pagetoken v0.0.0
 )
 
 require (
@@ -13,3 +15,7 @@ require (
 	golang.org/x/text v0.14.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240227224415-6ceb2ff114de // indirect
 )
+
+replace money => ../money
+
+replace pagetoken => ../pagetoken
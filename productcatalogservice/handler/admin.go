@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"money"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "productcatalogservice/proto"
+)
+
+// Create a product
+func (s *ProductCatalogService) CreateProduct(ctx context.Context, in *pb.CreateProductRequest) (out *pb.Product, e error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	p, err := validProduct(in.Product)
+	if err != nil {
+		return nil, err
+	}
+	if in.InitialQuantity < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid initial quantity %d", in.InitialQuantity)
+	}
+	err = s.update(func(products []*pb.Product) ([]*pb.Product, error) {
+		for _, old := range products {
+			if old.Id == p.Id {
+				return nil, status.Errorf(codes.AlreadyExists, "product %s already exists", p.Id)
+			}
+		}
+		return append(products, p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Printf("Created product %s", p.Id)
+
+	// a product that can't be stocked is taken out of the catalog again
+	if s.Inventory != nil {
+		if err := s.Inventory.createStock(stockKeys(p), in.InitialQuantity); err != nil {
+			if _, err := s.removeProduct(p.Id); err != nil {
+				logger.Printf("Failed to remove unstocked product %s: %+v", p.Id, err)
+			}
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Update a product
+func (s *ProductCatalogService) UpdateProduct(ctx context.Context, in *pb.UpdateProductRequest) (out *pb.Product, e error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	p, err := validProduct(in.Product)
+	if err != nil {
+		return nil, err
+	}
+	if in.InitialQuantity < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid initial quantity %d", in.InitialQuantity)
+	}
+	var previous *pb.Product
+	err = s.update(func(products []*pb.Product) ([]*pb.Product, error) {
+		for i, old := range products {
+			if old.Id == p.Id {
+				previous = old
+				products[i] = p
+				return products, nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", p.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Printf("Updated product %s", p.Id)
+
+	// stock follows the variants: added ones are stocked, removed ones can't be sold anymore
+	if s.Inventory != nil {
+		oldKeys, newKeys := stockKeys(previous), stockKeys(p)
+		if added := keysNotIn(newKeys, oldKeys); len(added) > 0 {
+			if err := s.Inventory.createStock(added, in.InitialQuantity); err != nil {
+				return nil, err
+			}
+		}
+		if removed := keysNotIn(oldKeys, newKeys); len(removed) > 0 {
+			if err := s.Inventory.removeStock(removed); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return p, nil
+}
+
+// Delete a product
+func (s *ProductCatalogService) DeleteProduct(ctx context.Context, in *pb.DeleteProductRequest) (out *pb.Empty, e error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	p, err := s.removeProduct(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	logger.Printf("Deleted product %s", in.Id)
+
+	// the stock of a deleted product can't be sold anymore
+	if s.Inventory != nil {
+		if err := s.Inventory.removeStock(stockKeys(p)); err != nil {
+			return nil, err
+		}
+	}
+	return new(pb.Empty), nil
+}
+
+// take a product out of the catalog, returning it
+func (s *ProductCatalogService) removeProduct(id string) (*pb.Product, error) {
+	var removed *pb.Product
+	err := s.update(func(products []*pb.Product) ([]*pb.Product, error) {
+		for i, old := range products {
+			if old.Id == id {
+				removed = old
+				return append(products[:i], products[i+1:]...), nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", id)
+	})
+	return removed, err
+}
+
+// stock keys of keys that aren't in other
+func keysNotIn(keys, other []string) []string {
+	var out []string
+	for _, k := range keys {
+		if !slices.Contains(other, k) {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// check the bearer token of an admin call
+func (s *ProductCatalogService) authorize(ctx context.Context) error {
+	if s.AdminToken == "" {
+		return status.Errorf(codes.PermissionDenied, "catalog admin API is disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		token, found := strings.CutPrefix(v, "Bearer ")
+		if found && subtle.ConstantTimeCompare([]byte(token), []byte(s.AdminToken)) == 1 {
+			return nil
+		}
+	}
+	return status.Errorf(codes.Unauthenticated, "missing or invalid admin token")
+}
+
+// update applies a change to a copy of the product list, saves it to the
+// product json file and only then swaps in the new catalog, so a failed write
+// changes nothing. Reloads and other updates wait for it to finish.
+func (s *ProductCatalogService) update(change func([]*pb.Product) ([]*pb.Product, error)) error {
+	s.reload.Lock()
+	defer s.reload.Unlock()
+	current := s.Catalog()
+	products, err := change(append([]*pb.Product(nil), current.Products()...))
+	if err != nil {
+		return err
+	}
+	catalog, err := NewCatalog(products, current.decls)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "    "}.Marshal(&pb.ListProductsResponse{Products: products})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to encode products: %v", err)
+	}
+	if err := writeFileAtomic(s.path, data); err != nil {
+		return status.Errorf(codes.Internal, "Failed to save products: %v", err)
+	}
+	s.catalog.Store(catalog)
+	return nil
+}
+
+// check a product sent by an admin, returning a copy the catalog can keep
+func validProduct(in *pb.Product) (*pb.Product, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "product is required")
+	}
+	p := proto.Clone(in).(*pb.Product)
+	p.Id = strings.TrimSpace(p.Id)
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product id is required")
+	}
+	if p.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product name is required")
+	}
+	if p.PriceUsd == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "product price is required")
+	}
+	price := money.From(p.PriceUsd)
+	if price.CurrencyCode != catalogCurrency || !money.IsValid(price) || money.IsNegative(price) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid price: %d units, %d nanos %s", price.Units, price.Nanos, price.CurrencyCode)
+	}
+	if p.WeightGrams < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid weight: %d grams", p.WeightGrams)
+	}
+	if d := p.Dimensions; d != nil && (d.LengthMm < 0 || d.WidthMm < 0 || d.HeightMm < 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid dimensions: %dx%dx%d mm", d.LengthMm, d.WidthMm, d.HeightMm)
+	}
+	for _, v := range p.Variants {
+		v.Sku = strings.TrimSpace(v.Sku)
+		if v.Sku == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "variant sku is required")
+		}
+		if v.PriceUsd == nil {
+			continue
+		}
+		price := money.From(v.PriceUsd)
+		if price.CurrencyCode != catalogCurrency || !money.IsValid(price) || money.IsNegative(price) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid price of sku %s: %d units, %d nanos %s", v.Sku, price.Units, price.Nanos, price.CurrencyCode)
+		}
+	}
+	return p, nil
+}
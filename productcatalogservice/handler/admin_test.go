@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "productcatalogservice/proto"
+)
+
+// an empty catalog with its inventory, and a context carrying its admin token
+func newAdminService(t *testing.T) (*ProductCatalogService, context.Context) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "products.json"), []byte(`{"products": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewProductCatalogService(filepath.Join(dir, "products.json"), filepath.Join(dir, "categories.json"))
+	if err != nil {
+		t.Fatalf("NewProductCatalogService: %v", err)
+	}
+	s.Inventory = newTestInventory(t, `{"stock": {}}`, nil)
+	s.AdminToken = "secret"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	return s, ctx
+}
+
+func testProduct(id string, skus ...string) *pb.Product {
+	p := &pb.Product{Id: id, Name: id, PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 10}}
+	for _, sku := range skus {
+		p.Variants = append(p.Variants, &pb.ProductVariant{Sku: sku})
+	}
+	return p
+}
+
+func TestAdminStock(t *testing.T) {
+	tests := []struct {
+		name string
+		// product variants before and after the update, nil for a product without variants
+		before, after []string
+		// stock after the update, by stock key; -1 for no stock record
+		want map[string]int32
+	}{
+		{name: "variant added", before: []string{"P-S", "P-M"}, after: []string{"P-S", "P-M", "P-L"}, want: map[string]int32{"P-S": 5, "P-M": 5, "P-L": 3}},
+		{name: "variant removed", before: []string{"P-S", "P-M"}, after: []string{"P-S"}, want: map[string]int32{"P-S": 5, "P-M": -1}},
+		{name: "variant renamed", before: []string{"P-S"}, after: []string{"P-SMALL"}, want: map[string]int32{"P-S": -1, "P-SMALL": 3}},
+		{name: "variants unchanged", before: []string{"P-S"}, after: []string{"P-S"}, want: map[string]int32{"P-S": 5}},
+		{name: "first variants", before: nil, after: []string{"P-S"}, want: map[string]int32{"P": -1, "P-S": 3}},
+		{name: "variants dropped", before: []string{"P-S"}, after: nil, want: map[string]int32{"P": 3, "P-S": -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ctx := newAdminService(t)
+			if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("P", tt.before...), InitialQuantity: 5}); err != nil {
+				t.Fatalf("CreateProduct: %v", err)
+			}
+			if _, err := s.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: testProduct("P", tt.after...), InitialQuantity: 3}); err != nil {
+				t.Fatalf("UpdateProduct: %v", err)
+			}
+			for key, want := range tt.want {
+				if got := onHand(t, s.Inventory, key); got != want {
+					t.Errorf("stock of %s = %d, want %d", key, got, want)
+				}
+			}
+
+			if _, err := s.DeleteProduct(ctx, &pb.DeleteProductRequest{Id: "P"}); err != nil {
+				t.Fatalf("DeleteProduct: %v", err)
+			}
+			if n := len(s.Inventory.state.Stock); n != 0 {
+				t.Fatalf("%d stock records left after DeleteProduct: %v", n, s.Inventory.state.Stock)
+			}
+		})
+	}
+}
+
+func TestAdminStockErrors(t *testing.T) {
+	s, ctx := newAdminService(t)
+	if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("P"), InitialQuantity: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("CreateProduct with a negative quantity = %v, want InvalidArgument", err)
+	}
+	if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("P"), InitialQuantity: 2}); err != nil {
+		t.Fatalf("CreateProduct: %v", err)
+	}
+	// a product created twice keeps its stock
+	s.Inventory.state.Stock["P"] = 1
+	if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("P"), InitialQuantity: 9}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("CreateProduct of an existing product = %v, want AlreadyExists", err)
+	}
+	if got := onHand(t, s.Inventory, "P"); got != 1 {
+		t.Fatalf("stock after a duplicate CreateProduct = %d, want 1", got)
+	}
+	if _, err := s.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: testProduct("Q", "Q-S")}); status.Code(err) != codes.NotFound {
+		t.Fatalf("UpdateProduct of a missing product = %v, want NotFound", err)
+	}
+	if _, ok := s.Inventory.state.Stock["Q-S"]; ok {
+		t.Fatal("UpdateProduct of a missing product created stock")
+	}
+}
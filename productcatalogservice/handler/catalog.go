@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "productcatalogservice/proto"
+)
+
+// Catalog is an immutable snapshot of the product catalog with its indexes.
+// A snapshot is never modified once built, so it can be read without locks;
+// a reload builds a new one and swaps it in whole.
+type Catalog struct {
+	products   []*pb.Product
+	byID       map[string]*pb.Product
+	bySKU      map[string]*pb.ProductVariant
+	byCategory map[string][]*pb.Product
+	index      *searchIndex
+	// declared categories, kept to rebuild the tree when products change
+	decls      []*CategoryDecl
+	categories *categoryTree
+	// categories of each product by id, with their ancestors
+	productCategories map[string][]string
+}
+
+// Build a catalog snapshot, rejecting duplicate or empty product IDs and SKUs.
+// SKUs share the namespace of product IDs, as both identify stock.
+func NewCatalog(products []*pb.Product, categories []*CategoryDecl) (*Catalog, error) {
+	c := &Catalog{
+		products:          products,
+		byID:              make(map[string]*pb.Product, len(products)),
+		bySKU:             make(map[string]*pb.ProductVariant),
+		byCategory:        make(map[string][]*pb.Product),
+		decls:             categories,
+		productCategories: make(map[string][]string, len(products)),
+	}
+	var err error
+	if c.categories, err = newCategoryTree(categories, products); err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		if p.GetId() == "" {
+			return nil, fmt.Errorf("product %q has no id", p.GetName())
+		}
+		if _, ok := c.byID[p.GetId()]; ok {
+			return nil, fmt.Errorf("duplicate product id %s", p.GetId())
+		}
+		c.byID[p.GetId()] = p
+		for _, v := range p.GetVariants() {
+			if v.GetSku() == "" {
+				return nil, fmt.Errorf("variant of product %s has no sku", p.GetId())
+			}
+			if _, ok := c.bySKU[v.GetSku()]; ok {
+				return nil, fmt.Errorf("duplicate sku %s", v.GetSku())
+			}
+			if v.PriceUsd != nil && v.PriceUsd.GetCurrencyCode() != catalogCurrency {
+				return nil, fmt.Errorf("price of sku %s is not in %s", v.GetSku(), catalogCurrency)
+			}
+			c.bySKU[v.GetSku()] = v
+		}
+		seen := make(map[string]bool)
+		for _, category := range p.GetCategories() {
+			for _, key := range c.categories.lineage(strings.ToLower(category)) {
+				if !seen[key] {
+					seen[key] = true
+					c.byCategory[key] = append(c.byCategory[key], p)
+					c.productCategories[p.GetId()] = append(c.productCategories[p.GetId()], key)
+				}
+			}
+		}
+	}
+	for sku := range c.bySKU {
+		if _, ok := c.byID[sku]; ok {
+			return nil, fmt.Errorf("sku %s is also a product id", sku)
+		}
+	}
+	for slug, node := range c.categories.nodes {
+		node.ProductCount = int32(len(c.byCategory[slug]))
+	}
+	c.index = newSearchIndex(products)
+	return c, nil
+}
+
+// load a catalog snapshot from a product json file and a category json file
+func loadCatalog(path, categoriesPath string) (*Catalog, error) {
+	categories, err := loadCategories(categoriesPath)
+	if err != nil {
+		return nil, err
+	}
+	catalogJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open product json file: %w", err)
+	}
+	catalog := &pb.ListProductsResponse{}
+	if err := protojson.Unmarshal(catalogJSON, catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse product json file: %w", err)
+	}
+	return NewCatalog(catalog.Products, categories)
+}
+
+// All products in file order
+func (c *Catalog) Products() []*pb.Product {
+	return c.products
+}
+
+// Product by id
+func (c *Catalog) Product(id string) (*pb.Product, bool) {
+	p, ok := c.byID[id]
+	return p, ok
+}
+
+// Variant by sku
+func (c *Catalog) Variant(sku string) (*pb.ProductVariant, bool) {
+	v, ok := c.bySKU[sku]
+	return v, ok
+}
+
+// Products of a category or its subcategories, matched case-insensitively
+func (c *Catalog) Category(category string) []*pb.Product {
+	return c.byCategory[strings.ToLower(category)]
+}
+
+// Top-level categories, each with its subcategories
+func (c *Catalog) Categories() []*pb.Category {
+	return c.categories.roots
+}
+
+// CategoryPath returns the categories from the top level down to the given one,
+// as shown in breadcrumbs, or false when there is no such category.
+func (c *Catalog) CategoryPath(category string) ([]*pb.Category, bool) {
+	slug := strings.ToLower(category)
+	if _, ok := c.categories.nodes[slug]; !ok {
+		return nil, false
+	}
+	lineage := c.categories.lineage(slug)
+	path := make([]*pb.Category, len(lineage))
+	for i, s := range lineage {
+		path[len(lineage)-1-i] = c.categories.nodes[s]
+	}
+	return path, true
+}
+
+// categories of a product along with their ancestors
+func (c *Catalog) categoriesOf(p *pb.Product) []string {
+	return c.productCategories[p.GetId()]
+}
+
+// Products matching a query, most relevant first, with their scores
+func (c *Catalog) Search(query string) ([]*pb.Product, []float64) {
+	return c.index.Search(query)
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pb "productcatalogservice/proto"
+)
+
+// A category declared in the category json file
+type CategoryDecl struct {
+	Slug     string          `json:"slug"`
+	Name     string          `json:"name"`
+	Children []*CategoryDecl `json:"children,omitempty"`
+}
+
+// Category tree of a catalog snapshot. Declared categories keep their place in
+// the tree; categories used by products but not declared are added as top-level
+// ones, so that every product category can be browsed.
+type categoryTree struct {
+	roots  []*pb.Category
+	nodes  map[string]*pb.Category
+	parent map[string]string
+}
+
+// read the category json file; without one every category is top-level
+func loadCategories(path string) ([]*CategoryDecl, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open category json file: %w", err)
+	}
+	var decls []*CategoryDecl
+	if err := json.Unmarshal(data, &decls); err != nil {
+		return nil, fmt.Errorf("failed to parse category json file: %w", err)
+	}
+	return decls, nil
+}
+
+// build the tree of declared categories and the ones products use
+func newCategoryTree(decls []*CategoryDecl, products []*pb.Product) (*categoryTree, error) {
+	t := &categoryTree{nodes: make(map[string]*pb.Category), parent: make(map[string]string)}
+	var add func(decls []*CategoryDecl, parent string) ([]*pb.Category, error)
+	add = func(decls []*CategoryDecl, parent string) ([]*pb.Category, error) {
+		var out []*pb.Category
+		for _, d := range decls {
+			slug := strings.ToLower(strings.TrimSpace(d.Slug))
+			if slug == "" {
+				return nil, fmt.Errorf("category %q has no slug", d.Name)
+			}
+			if _, ok := t.nodes[slug]; ok {
+				return nil, fmt.Errorf("duplicate category %s", slug)
+			}
+			node := &pb.Category{Slug: slug, Name: d.Name}
+			if node.Name == "" {
+				node.Name = categoryName(slug)
+			}
+			t.nodes[slug] = node
+			if parent != "" {
+				t.parent[slug] = parent
+			}
+			children, err := add(d.Children, slug)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = children
+			out = append(out, node)
+		}
+		return out, nil
+	}
+	var err error
+	if t.roots, err = add(decls, ""); err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		for _, c := range p.GetCategories() {
+			slug := strings.ToLower(c)
+			if _, ok := t.nodes[slug]; !ok && slug != "" {
+				node := &pb.Category{Slug: slug, Name: categoryName(slug)}
+				t.nodes[slug] = node
+				t.roots = append(t.roots, node)
+			}
+		}
+	}
+	return t, nil
+}
+
+// a category and its ancestors, from the category up
+func (t *categoryTree) lineage(slug string) []string {
+	var out []string
+	for ok := true; ok; slug, ok = t.parent[slug] {
+		out = append(out, slug)
+	}
+	return out
+}
+
+// display name of an undeclared category, e.g. "Hair care" for "hair-care"
+func categoryName(slug string) string {
+	name := strings.NewReplacer("-", " ", "_", " ").Replace(slug)
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "productcatalogservice/proto"
+)
+
+// Bounds of how long a reservation holds without a commit
+const (
+	defaultReservationTTL = 10 * time.Minute
+	maxReservationTTL     = time.Hour
+)
+
+// How long a commit can still be released, putting its units back in stock
+const commitRetention = 24 * time.Hour
+
+// Units of a product set aside for an order until it is committed or released
+type reservation struct {
+	Items     map[string]int32 `json:"items"`
+	ExpiresAt time.Time        `json:"expires_at"`
+}
+
+// What is saved to the inventory file
+type inventoryState struct {
+	// units on hand per stock key, including reserved ones
+	Stock        map[string]int32        `json:"stock"`
+	Reservations map[string]*reservation `json:"reservations,omitempty"`
+	// recent commits, which expire once they can no longer be released
+	Committed map[string]*reservation `json:"committed,omitempty"`
+}
+
+// Inventory tracks the stock of every product and the reservations of pending
+// orders. Reserved units are no longer available to other orders; a commit takes
+// them out of stock, a release or an expired TTL puts them back. A commit can
+// itself be released for a day, for orders that fail after their stock is gone.
+// Every change is saved to the inventory file before it is acknowledged.
+// Expired reservations are dropped lazily, by the next call that looks at them.
+type Inventory struct {
+	sync.Mutex
+	path  string
+	state inventoryState
+	now   func() time.Time
+}
+
+// Load the inventory from a json file
+func NewInventory(path string) (*Inventory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open inventory file: %w", err)
+	}
+	inv := &Inventory{path: path, now: time.Now}
+	if err := json.Unmarshal(data, &inv.state); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory file: %w", err)
+	}
+	if inv.state.Stock == nil {
+		inv.state.Stock = make(map[string]int32)
+	}
+	if inv.state.Reservations == nil {
+		inv.state.Reservations = make(map[string]*reservation)
+	}
+	if inv.state.Committed == nil {
+		inv.state.Committed = make(map[string]*reservation)
+	}
+	return inv, nil
+}
+
+// Get stock levels
+func (inv *Inventory) GetStock(ctx context.Context, in *pb.GetStockRequest) (out *pb.GetStockResponse, e error) {
+	inv.Lock()
+	defer inv.Unlock()
+	inv.expire()
+
+	ids := in.ProductIds
+	if len(ids) == 0 {
+		for id := range inv.state.Stock {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+	}
+	out = new(pb.GetStockResponse)
+	for _, id := range ids {
+		onHand, ok := inv.state.Stock[id]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "no stock for product %s", id)
+		}
+		reserved := inv.reserved(id)
+		out.Stock = append(out.Stock, &pb.StockLevel{ProductId: id, Available: onHand - reserved, Reserved: reserved})
+	}
+	return out, nil
+}
+
+// Reserve stock for an order
+func (inv *Inventory) Reserve(ctx context.Context, in *pb.ReserveRequest) (out *pb.ReserveResponse, e error) {
+	if in.OrderId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "order id is required")
+	}
+	if len(in.Items) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to reserve")
+	}
+	ttl := defaultReservationTTL
+	if in.Ttl != nil {
+		if err := in.Ttl.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid ttl: %v", err)
+		}
+		ttl = in.Ttl.AsDuration()
+		if ttl <= 0 || ttl > maxReservationTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl must be between 0 and %s", maxReservationTTL)
+		}
+	}
+	items := make(map[string]int32)
+	for _, it := range in.Items {
+		if it.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid quantity %d for product %s", it.GetQuantity(), it.GetProductId())
+		}
+		items[stockKey(it)] += it.GetQuantity()
+	}
+
+	inv.Lock()
+	defer inv.Unlock()
+	inv.expire()
+	if _, ok := inv.state.Reservations[in.OrderId]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "order %s already has a reservation", in.OrderId)
+	}
+	for id, quantity := range items {
+		onHand, ok := inv.state.Stock[id]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "no stock for product %s", id)
+		}
+		if available := onHand - inv.reserved(id); available < quantity {
+			return nil, status.Errorf(codes.FailedPrecondition, "Insufficient stock for product %s: %d available, %d requested", id, available, quantity)
+		}
+	}
+
+	r := &reservation{Items: items, ExpiresAt: inv.now().Add(ttl)}
+	inv.state.Reservations[in.OrderId] = r
+	if err := inv.save(); err != nil {
+		delete(inv.state.Reservations, in.OrderId)
+		return nil, status.Errorf(codes.Internal, "Failed to save reservation: %v", err)
+	}
+	logger.Printf("Reserved stock for order %s until %s", in.OrderId, r.ExpiresAt.Format(time.RFC3339))
+	return &pb.ReserveResponse{OrderId: in.OrderId, ExpiresAt: timestamppb.New(r.ExpiresAt)}, nil
+}
+
+// Commit a reservation, taking its units out of stock; committing it again is a no-op
+func (inv *Inventory) Commit(ctx context.Context, in *pb.CommitRequest) (out *pb.Empty, e error) {
+	inv.Lock()
+	defer inv.Unlock()
+	inv.expire()
+	if _, ok := inv.state.Committed[in.OrderId]; ok {
+		return new(pb.Empty), nil
+	}
+	r, ok := inv.state.Reservations[in.OrderId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no pending reservation for order %s", in.OrderId)
+	}
+	inv.addStock(r.Items, -1)
+	c := &reservation{Items: r.Items, ExpiresAt: inv.now().Add(commitRetention)}
+	delete(inv.state.Reservations, in.OrderId)
+	inv.state.Committed[in.OrderId] = c
+	if err := inv.save(); err != nil {
+		inv.addStock(r.Items, 1)
+		delete(inv.state.Committed, in.OrderId)
+		inv.state.Reservations[in.OrderId] = r
+		return nil, status.Errorf(codes.Internal, "Failed to save commit: %v", err)
+	}
+	logger.Printf("Committed stock for order %s", in.OrderId)
+	return new(pb.Empty), nil
+}
+
+// Release a reservation, or put the units of a recent commit back in stock;
+// releasing an unknown or expired one is a no-op
+func (inv *Inventory) Release(ctx context.Context, in *pb.ReleaseRequest) (out *pb.Empty, e error) {
+	inv.Lock()
+	defer inv.Unlock()
+	inv.expire()
+	if c, ok := inv.state.Committed[in.OrderId]; ok {
+		inv.addStock(c.Items, 1)
+		delete(inv.state.Committed, in.OrderId)
+		if err := inv.save(); err != nil {
+			inv.addStock(c.Items, -1)
+			inv.state.Committed[in.OrderId] = c
+			return nil, status.Errorf(codes.Internal, "Failed to save release: %v", err)
+		}
+		logger.Printf("Returned committed stock of order %s", in.OrderId)
+		return new(pb.Empty), nil
+	}
+	r, ok := inv.state.Reservations[in.OrderId]
+	if !ok {
+		return new(pb.Empty), nil
+	}
+	delete(inv.state.Reservations, in.OrderId)
+	if err := inv.save(); err != nil {
+		inv.state.Reservations[in.OrderId] = r
+		return nil, status.Errorf(codes.Internal, "Failed to save release: %v", err)
+	}
+	logger.Printf("Released stock for order %s", in.OrderId)
+	return new(pb.Empty), nil
+}
+
+// Set the stock of new stock keys, or reset that of existing ones
+func (inv *Inventory) createStock(keys []string, quantity int32) error {
+	inv.Lock()
+	defer inv.Unlock()
+	old := maps.Clone(inv.state.Stock)
+	for _, id := range keys {
+		inv.state.Stock[id] = quantity
+	}
+	if err := inv.save(); err != nil {
+		inv.state.Stock = old
+		return status.Errorf(codes.Internal, "Failed to save stock: %v", err)
+	}
+	logger.Printf("Created stock of %d units for %s", quantity, strings.Join(keys, ", "))
+	return nil
+}
+
+// Remove the stock of stock keys; reservations holding them are left to be
+// committed or released, which skip the removed keys
+func (inv *Inventory) removeStock(keys []string) error {
+	inv.Lock()
+	defer inv.Unlock()
+	old := maps.Clone(inv.state.Stock)
+	for _, id := range keys {
+		delete(inv.state.Stock, id)
+	}
+	if err := inv.save(); err != nil {
+		inv.state.Stock = old
+		return status.Errorf(codes.Internal, "Failed to save stock: %v", err)
+	}
+	logger.Printf("Removed stock of %s", strings.Join(keys, ", "))
+	return nil
+}
+
+// Stock keys of a product: its variant SKUs, or its ID when it has none
+func stockKeys(p *pb.Product) []string {
+	if len(p.GetVariants()) == 0 {
+		return []string{p.GetId()}
+	}
+	keys := make([]string, len(p.GetVariants()))
+	for i, v := range p.GetVariants() {
+		keys[i] = v.GetSku()
+	}
+	return keys
+}
+
+// Stock is kept per variant SKU, or per product for products without variants
+func stockKey(item *pb.CartItem) string {
+	if item.GetVariantSku() != "" {
+		return item.GetVariantSku()
+	}
+	return item.GetProductId()
+}
+
+// The helpers below expect the caller to hold the lock.
+
+// drop expired reservations, and commits too old to be released
+func (inv *Inventory) expire() {
+	now := inv.now()
+	for orderID, r := range inv.state.Reservations {
+		if !now.Before(r.ExpiresAt) {
+			delete(inv.state.Reservations, orderID)
+			logger.Printf("Reservation of order %s expired", orderID)
+		}
+	}
+	for orderID, c := range inv.state.Committed {
+		if !now.Before(c.ExpiresAt) {
+			delete(inv.state.Committed, orderID)
+		}
+	}
+}
+
+// add the units of items to stock, sign times each quantity; keys no longer
+// stocked are skipped
+func (inv *Inventory) addStock(items map[string]int32, sign int32) {
+	for id, quantity := range items {
+		if _, ok := inv.state.Stock[id]; ok {
+			inv.state.Stock[id] += sign * quantity
+		}
+	}
+}
+
+// units of a product held by reservations
+func (inv *Inventory) reserved(productID string) int32 {
+	var n int32
+	for _, r := range inv.state.Reservations {
+		n += r.Items[productID]
+	}
+	return n
+}
+
+// write the inventory file
+func (inv *Inventory) save() error {
+	data, err := json.MarshalIndent(inv.state, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(inv.path, data)
+}
+
+// replace a file with new content, so that a crash leaves either the old or the new one
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	if d, err := os.Open(filepath.Dir(path)); err == nil {
+		d.Sync()
+		d.Close()
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "productcatalogservice/proto"
+)
+
+// an inventory over a file holding state, with its clock read from now when it isn't nil
+func newTestInventory(t *testing.T, state string, now *time.Time) *Inventory {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "inventory.json")
+	if err := os.WriteFile(path, []byte(state), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	inv, err := NewInventory(path)
+	if err != nil {
+		t.Fatalf("NewInventory: %v", err)
+	}
+	if now != nil {
+		inv.now = func() time.Time { return *now }
+	}
+	return inv
+}
+
+// units on hand of a stock key, -1 when it has no stock record
+func onHand(t *testing.T, inv *Inventory, key string) int32 {
+	t.Helper()
+	inv.Lock()
+	defer inv.Unlock()
+	n, ok := inv.state.Stock[key]
+	if !ok {
+		return -1
+	}
+	return n
+}
+
+// One step against an inventory holding 5 MUG and 2 TANK-M: a call, the clock
+// moved forward before it, and what it should return
+type inventoryStep struct {
+	advance time.Duration
+	call    string
+	order   string
+	items   []*pb.CartItem
+	ttl     time.Duration
+	code    codes.Code
+}
+
+func mugs(n int32) []*pb.CartItem {
+	return []*pb.CartItem{{ProductId: "MUG", Quantity: n}}
+}
+
+func TestInventory(t *testing.T) {
+	const state = `{"stock": {"MUG": 5, "TANK-M": 2}}`
+	tests := []struct {
+		name  string
+		steps []inventoryStep
+		// units on hand and reserved of MUG afterwards
+		onHand, reserved int32
+	}{
+		{
+			name:   "reserve what is available",
+			steps:  []inventoryStep{{call: "reserve", order: "o1", items: mugs(5)}},
+			onHand: 5, reserved: 5,
+		},
+		{
+			name: "reserve past available stock",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(3)},
+				{call: "reserve", order: "o2", items: mugs(3), code: codes.FailedPrecondition},
+			},
+			onHand: 5, reserved: 3,
+		},
+		{
+			name: "lines of the same key add up",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: append(mugs(3), mugs(3)...), code: codes.FailedPrecondition},
+			},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "variant stock",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: []*pb.CartItem{{ProductId: "TANK", VariantSku: "TANK-M", Quantity: 3}}, code: codes.FailedPrecondition},
+				{call: "reserve", order: "o2", items: []*pb.CartItem{{ProductId: "TANK", VariantSku: "TANK-M", Quantity: 2}}},
+			},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "invalid reservations",
+			steps: []inventoryStep{
+				{call: "reserve", order: "", items: mugs(1), code: codes.InvalidArgument},
+				{call: "reserve", order: "o1", code: codes.InvalidArgument},
+				{call: "reserve", order: "o1", items: mugs(0), code: codes.InvalidArgument},
+				{call: "reserve", order: "o1", items: mugs(1), ttl: 2 * time.Hour, code: codes.InvalidArgument},
+				{call: "reserve", order: "o1", items: []*pb.CartItem{{ProductId: "NOPE", Quantity: 1}}, code: codes.NotFound},
+			},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "one reservation per order",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(1)},
+				{call: "reserve", order: "o1", items: mugs(1), code: codes.AlreadyExists},
+			},
+			onHand: 5, reserved: 1,
+		},
+		{
+			name: "expiry gives stock back",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(5), ttl: time.Minute},
+				{advance: time.Minute - time.Nanosecond, call: "reserve", order: "o2", items: mugs(1), code: codes.FailedPrecondition},
+				{advance: time.Nanosecond, call: "reserve", order: "o2", items: mugs(5)},
+			},
+			onHand: 5, reserved: 5,
+		},
+		{
+			name: "expired reservation can't be committed",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(2)},
+				{advance: defaultReservationTTL, call: "commit", order: "o1", code: codes.NotFound},
+			},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "commit takes stock out",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(2)},
+				{call: "commit", order: "o1"},
+				{call: "reserve", order: "o2", items: mugs(4), code: codes.FailedPrecondition},
+			},
+			onHand: 3, reserved: 0,
+		},
+		{
+			name: "commit is idempotent",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(2)},
+				{call: "commit", order: "o1"},
+				{call: "commit", order: "o1"},
+				{advance: time.Hour, call: "commit", order: "o1"},
+			},
+			onHand: 3, reserved: 0,
+		},
+		{
+			name:   "commit without a reservation",
+			steps:  []inventoryStep{{call: "commit", order: "o1", code: codes.NotFound}},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "release gives stock back",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(5)},
+				{call: "release", order: "o1"},
+				{call: "release", order: "o1"},
+				{call: "reserve", order: "o2", items: mugs(5)},
+			},
+			onHand: 5, reserved: 5,
+		},
+		{
+			name:   "release of an unknown order",
+			steps:  []inventoryStep{{call: "release", order: "o1"}},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "release after commit returns the stock once",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(2)},
+				{call: "commit", order: "o1"},
+				{call: "release", order: "o1"},
+				{call: "release", order: "o1"},
+				{call: "commit", order: "o1", code: codes.NotFound},
+			},
+			onHand: 5, reserved: 0,
+		},
+		{
+			name: "commits can't be released after a day",
+			steps: []inventoryStep{
+				{call: "reserve", order: "o1", items: mugs(2)},
+				{call: "commit", order: "o1"},
+				{advance: commitRetention, call: "release", order: "o1"},
+			},
+			onHand: 3, reserved: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+			inv := newTestInventory(t, state, &now)
+			for i, step := range tt.steps {
+				now = now.Add(step.advance)
+				var err error
+				switch step.call {
+				case "reserve":
+					in := &pb.ReserveRequest{OrderId: step.order, Items: step.items}
+					if step.ttl != 0 {
+						in.Ttl = durationpb.New(step.ttl)
+					}
+					_, err = inv.Reserve(ctx, in)
+				case "commit":
+					_, err = inv.Commit(ctx, &pb.CommitRequest{OrderId: step.order})
+				case "release":
+					_, err = inv.Release(ctx, &pb.ReleaseRequest{OrderId: step.order})
+				}
+				if status.Code(err) != step.code {
+					t.Fatalf("step %d, %s of %s = %v, want %s", i, step.call, step.order, err, step.code)
+				}
+			}
+			out, err := inv.GetStock(ctx, &pb.GetStockRequest{ProductIds: []string{"MUG"}})
+			if err != nil {
+				t.Fatalf("GetStock: %v", err)
+			}
+			level := out.Stock[0]
+			if onHand := level.Available + level.Reserved; onHand != tt.onHand || level.Reserved != tt.reserved {
+				t.Fatalf("MUG on hand %d, reserved %d, want %d, %d", onHand, level.Reserved, tt.onHand, tt.reserved)
+			}
+		})
+	}
+}
+
+// reservations and commits are saved, and hold after a restart
+func TestInventorySurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	inv := newTestInventory(t, `{"stock": {"MUG": 5}}`, &now)
+	for _, order := range []string{"o1", "o2"} {
+		if _, err := inv.Reserve(ctx, &pb.ReserveRequest{OrderId: order, Items: mugs(2)}); err != nil {
+			t.Fatalf("Reserve: %v", err)
+		}
+	}
+	if _, err := inv.Commit(ctx, &pb.CommitRequest{OrderId: "o1"}); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	again, err := NewInventory(inv.path)
+	if err != nil {
+		t.Fatalf("NewInventory: %v", err)
+	}
+	again.now = inv.now
+	if got := onHand(t, again, "MUG"); got != 3 {
+		t.Fatalf("MUG on hand after a restart = %d, want 3", got)
+	}
+	if _, err := again.Reserve(ctx, &pb.ReserveRequest{OrderId: "o3", Items: mugs(2)}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Reserve past stock after a restart = %v, want FailedPrecondition", err)
+	}
+	if _, err := again.Release(ctx, &pb.ReleaseRequest{OrderId: "o1"}); err != nil {
+		t.Fatalf("Release of a commit after a restart: %v", err)
+	}
+	if got := onHand(t, again, "MUG"); got != 5 {
+		t.Fatalf("MUG on hand after releasing the commit = %d, want 5", got)
+	}
+}
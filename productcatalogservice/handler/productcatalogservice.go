@@ -6,19 +6,16 @@ import (
 	"log"
 	"os"
 	"os/signal"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
 
 	pb "productcatalogservice/proto"
 )
 
-var reloadCatalog bool
-
 // log
 var (
 	buf    bytes.Buffer
@@ -27,99 +24,117 @@ var (
 
 // struct
 type ProductCatalogService struct {
-	sync.Mutex
-	products []*pb.Product
+	path           string
+	categoriesPath string
+	catalog        atomic.Pointer[Catalog]
+	// serializes reloads and admin updates, reads never take it
+	reload sync.Mutex
+	// bearer token of the admin RPCs, which are disabled when it is empty
+	AdminToken string
+	// where the admin RPCs create and remove the stock of products, left
+	// alone when nil
+	Inventory *Inventory
+}
+
+// Instantiate the service with the catalog read from a product json file and
+// the category tree declared in a category json file, which may be missing
+func NewProductCatalogService(path, categoriesPath string) (*ProductCatalogService, error) {
+	s := &ProductCatalogService{path: path, categoriesPath: categoriesPath}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Current catalog snapshot
+func (s *ProductCatalogService) Catalog() *Catalog {
+	return s.catalog.Load()
+}
+
+// Reload reads the product and category json files again and swaps in the new catalog.
+// On error the current catalog stays in place.
+func (s *ProductCatalogService) Reload() error {
+	s.reload.Lock()
+	defer s.reload.Unlock()
+	catalog, err := loadCatalog(s.path, s.categoriesPath)
+	if err != nil {
+		logger.Printf("Failed to load product catalog: %v", err)
+		return err
+	}
+	s.catalog.Store(catalog)
+	logger.Printf("Loaded %d products from %s", len(catalog.Products()), s.path)
+	return nil
 }
 
 // product list
-func (s *ProductCatalogService) ListProducts(ctx context.Context, in *pb.Empty) (out *pb.ListProductsResponse, e error) {
+func (s *ProductCatalogService) ListProducts(ctx context.Context, in *pb.ListProductsRequest) (out *pb.ListProductsResponse, e error) {
+	q, err := newProductQuery(in.Category, in.MinPriceUsd, in.MaxPriceUsd, in.Sort, in.PageSize, in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	catalog := s.Catalog()
+	page := q.apply(catalog, catalog.Products(), nil)
 	out = new(pb.ListProductsResponse)
-	out.Products = s.parseCatalog()
+	out.Products = page.products
+	out.NextPageToken = page.nextPageToken
+	out.Facets = page.facets
+	out.TotalSize = page.totalSize
 	return out, nil
 }
 
 // get product by id
 func (s *ProductCatalogService) GetProduct(ctx context.Context, in *pb.GetProductRequest) (out *pb.Product, e error) {
-	var found *pb.Product
-	out = new(pb.Product)
-	products := s.parseCatalog()
-	for _, p := range products {
-		if in.Id == p.Id {
-			found = p
-		}
-	}
-	if found == nil {
-		return out, status.Errorf(codes.NotFound, "no product with ID %s", in.Id)
+	found, ok := s.Catalog().Product(in.Id)
+	if !ok {
+		return new(pb.Product), status.Errorf(codes.NotFound, "no product with ID %s", in.Id)
 	}
-	out.Id = found.Id
-	out.Name = found.Name
-	out.Categories = found.Categories
-	out.Description = found.Description
-	out.Picture = found.Picture
-	out.PriceUsd = found.PriceUsd
-	return out, nil
+	return found, nil
 }
 
-// search product by name or description
+// search product by name, categories or description, ranked by relevance
 func (s *ProductCatalogService) SearchProducts(ctx context.Context, in *pb.SearchProductsRequest) (out *pb.SearchProductsResponse, e error) {
-	var ps []*pb.Product
-	out = new(pb.SearchProductsResponse)
-	products := s.parseCatalog()
-	for _, p := range products {
-		if strings.Contains(strings.ToLower(p.Name), strings.ToLower(in.Query)) ||
-			strings.Contains(strings.ToLower(p.Description), strings.ToLower(in.Query)) {
-			ps = append(ps, p)
-		}
-	}
-	out.Results = ps
-	return out, nil
-}
-
-// load product json file
-func (s *ProductCatalogService) readCatalogFile() (*pb.ListProductsResponse, error) {
-	s.Lock()
-	defer s.Unlock()
-	catalogJSON, err := os.ReadFile("data/products.json")
+	q, err := newProductQuery(in.Category, in.MinPriceUsd, in.MaxPriceUsd, in.Sort, in.PageSize, in.PageToken)
 	if err != nil {
-		logger.Printf("Failed to open product json file: %v", err)
-		return nil, err
-	}
-	catalog := &pb.ListProductsResponse{}
-	if err := protojson.Unmarshal(catalogJSON, catalog); err != nil {
-		logger.Printf("Failed to parse product json file: %v", err)
 		return nil, err
 	}
-	logger.Printf("Parsing Product json file Succeeded")
-	return catalog, nil
+	catalog := s.Catalog()
+	products, scores := catalog.Search(in.Query)
+	page := q.apply(catalog, products, scores)
+	out = new(pb.SearchProductsResponse)
+	out.Results = page.products
+	out.Scores = page.scores
+	out.NextPageToken = page.nextPageToken
+	out.Facets = page.facets
+	out.TotalSize = page.totalSize
+	return out, nil
 }
 
-// parse product json file
-func (s *ProductCatalogService) parseCatalog() []*pb.Product {
-	if reloadCatalog || len(s.products) == 0 {
-		catalog, err := s.readCatalogFile()
-		if err != nil {
-			return []*pb.Product{}
-		}
-		s.products = catalog.Products
-	}
-	return s.products
+// category tree
+func (s *ProductCatalogService) ListCategories(ctx context.Context, in *pb.Empty) (out *pb.ListCategoriesResponse, e error) {
+	out = new(pb.ListCategoriesResponse)
+	out.Categories = s.Catalog().Categories()
+	return out, nil
 }
 
-// initialize
-func init() {
+// WatchSignals reloads the catalog on SIGUSR1 until ctx is done
+func (s *ProductCatalogService) WatchSignals(ctx context.Context) {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGUSR1, syscall.SIGUSR2)
-	go func() {
-		for {
-			sig := <-sigs
+	defer signal.Stop(sigs)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-sigs:
 			logger.Printf("Receive Signal: %s", sig)
-			if sig == syscall.SIGUSR1 {
-				reloadCatalog = true
-				logger.Printf("Product info can be loaded")
-			} else {
-				reloadCatalog = false
-				logger.Printf("Can't load product info")
+			if sig != syscall.SIGUSR1 {
+				// still caught so that it doesn't kill the process
+				logger.Printf("Send SIGUSR1 to reload product info")
+				continue
+			}
+			if err := s.Reload(); err != nil {
+				logger.Printf("Keeping current product info")
 			}
 		}
-	}()
+	}
 }
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"money"
+	"pagetoken"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "productcatalogservice/proto"
+)
+
+// Currency of catalog prices and price filters
+const catalogCurrency = "USD"
+
+// Bounds of a product page
+const (
+	defaultProductsPageSize = 10
+	maxProductsPageSize     = 100
+)
+
+// Filters, sort order and paging shared by ListProducts and SearchProducts
+type productQuery struct {
+	category string
+	minPrice *money.Money
+	maxPrice *money.Money
+	sort     pb.ProductSort
+	pageSize int
+	offset   int
+}
+
+// A page of products with the scores they came with, and what describes the whole result
+type productPage struct {
+	products      []*pb.Product
+	scores        []float64
+	nextPageToken string
+	facets        []*pb.CategoryFacet
+	totalSize     int32
+}
+
+// validate the query fields of a request
+func newProductQuery(category string, minPrice, maxPrice *pb.Money, sort pb.ProductSort, pageSize int32, pageToken string) (productQuery, error) {
+	q := productQuery{category: strings.ToLower(category), sort: sort, pageSize: int(pageSize)}
+	if _, ok := pb.ProductSort_name[int32(sort)]; !ok {
+		return q, status.Errorf(codes.InvalidArgument, "Unknown sort order: %d", sort)
+	}
+	switch {
+	case pageSize < 0:
+		return q, status.Errorf(codes.InvalidArgument, "Invalid page size: %d", pageSize)
+	case pageSize == 0:
+		q.pageSize = defaultProductsPageSize
+	case pageSize > maxProductsPageSize:
+		q.pageSize = maxProductsPageSize
+	}
+	var err error
+	if q.offset, err = pagetoken.Decode(pageToken); err != nil {
+		return q, status.Errorf(codes.InvalidArgument, "Invalid page token: %q", pageToken)
+	}
+	if q.minPrice, err = priceFilter(minPrice); err != nil {
+		return q, err
+	}
+	if q.maxPrice, err = priceFilter(maxPrice); err != nil {
+		return q, err
+	}
+	return q, nil
+}
+
+// a price bound in USD, nil when unset
+func priceFilter(m *pb.Money) (*money.Money, error) {
+	if m == nil {
+		return nil, nil
+	}
+	bound := money.From(m)
+	if bound.CurrencyCode == "" {
+		bound.CurrencyCode = catalogCurrency
+	}
+	if bound.CurrencyCode != catalogCurrency || !money.IsValid(bound) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid price filter: %d units, %d nanos %s", m.GetUnits(), m.GetNanos(), m.GetCurrencyCode())
+	}
+	return &bound, nil
+}
+
+// Apply the query to catalog products in relevance order, along with their scores
+// (nil for ListProducts). A category matches its subcategories' products too.
+// Facets count the products passing the price filter in each category, so that
+// picking another category doesn't need another request.
+func (q productQuery) apply(c *Catalog, products []*pb.Product, scores []float64) productPage {
+	type match struct {
+		product *pb.Product
+		score   float64
+	}
+	counts := make(map[string]int32)
+	var matches []match
+	for i, p := range products {
+		if !q.inPriceRange(p) {
+			continue
+		}
+		inCategory := q.category == ""
+		for _, category := range c.categoriesOf(p) {
+			counts[category]++
+			inCategory = inCategory || category == q.category
+		}
+		if !inCategory {
+			continue
+		}
+		m := match{product: p}
+		if scores != nil {
+			m.score = scores[i]
+		}
+		matches = append(matches, m)
+	}
+
+	switch q.sort {
+	case pb.ProductSort_PRODUCT_SORT_PRICE_ASC, pb.ProductSort_PRODUCT_SORT_PRICE_DESC:
+		sort.SliceStable(matches, func(i, j int) bool {
+			c, _ := money.Compare(money.From(matches[i].product.GetPriceUsd()), money.From(matches[j].product.GetPriceUsd()))
+			if q.sort == pb.ProductSort_PRODUCT_SORT_PRICE_DESC {
+				return c > 0
+			}
+			return c < 0
+		})
+	case pb.ProductSort_PRODUCT_SORT_NAME:
+		sort.SliceStable(matches, func(i, j int) bool {
+			return strings.ToLower(matches[i].product.GetName()) < strings.ToLower(matches[j].product.GetName())
+		})
+	}
+
+	out := productPage{totalSize: int32(len(matches))}
+	for c, n := range counts {
+		out.facets = append(out.facets, &pb.CategoryFacet{Category: c, Count: n})
+	}
+	sort.Slice(out.facets, func(i, j int) bool { return out.facets[i].Category < out.facets[j].Category })
+
+	start := min(q.offset, len(matches))
+	end := len(matches)
+	if start+q.pageSize < end {
+		end = start + q.pageSize
+		out.nextPageToken = pagetoken.Encode(end)
+	}
+	for _, m := range matches[start:end] {
+		out.products = append(out.products, m.product)
+		out.scores = append(out.scores, m.score)
+	}
+	return out
+}
+
+// check a product price against the price range
+func (q productQuery) inPriceRange(p *pb.Product) bool {
+	price := money.From(p.GetPriceUsd())
+	if q.minPrice != nil {
+		if c, err := money.Compare(price, *q.minPrice); err != nil || c < 0 {
+			return false
+		}
+	}
+	if q.maxPrice != nil {
+		if c, err := money.Compare(price, *q.maxPrice); err != nil || c > 0 {
+			return false
+		}
+	}
+	return true
+}
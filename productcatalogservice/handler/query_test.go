@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"fmt"
+	"pagetoken"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "productcatalogservice/proto"
+)
+
+func usd(units int64, nanos int32) *pb.Money {
+	return &pb.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+}
+
+// a catalog with home and clothing subcategories, and accessories left undeclared
+func newQueryCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	products := []*pb.Product{
+		{Id: "MUG", Name: "mug", Categories: []string{"kitchen"}, PriceUsd: usd(8, 500000000)},
+		{Id: "TEAPOT", Name: "Teapot", Categories: []string{"kitchen"}, PriceUsd: usd(25, 0)},
+		{Id: "CANDLES", Name: "Candle Holders", Categories: []string{"decor"}, PriceUsd: usd(12, 0)},
+		{Id: "HAT", Name: "Hat", Categories: []string{"accessories"}, PriceUsd: usd(15, 0)},
+		{Id: "SUNGLASSES", Name: "Sunglasses", Categories: []string{"Accessories"}, PriceUsd: usd(19, 990000000)},
+		{Id: "TANK", Name: "Tank Top", Categories: []string{"tops", "decor"}, PriceUsd: usd(18, 990000000)},
+	}
+	categories := []*CategoryDecl{
+		{Slug: "home", Children: []*CategoryDecl{{Slug: "decor"}, {Slug: "kitchen"}}},
+		{Slug: "clothing", Children: []*CategoryDecl{{Slug: "tops"}}},
+	}
+	c, err := NewCatalog(products, categories)
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+	return c
+}
+
+func ids(products []*pb.Product) []string {
+	var out []string
+	for _, p := range products {
+		out = append(out, p.GetId())
+	}
+	return out
+}
+
+func facets(page productPage) []string {
+	var out []string
+	for _, f := range page.facets {
+		out = append(out, fmt.Sprintf("%s:%d", f.GetCategory(), f.GetCount()))
+	}
+	return out
+}
+
+func TestProductQuery(t *testing.T) {
+	all := []string{"accessories:2", "clothing:1", "decor:2", "home:4", "kitchen:2", "tops:1"}
+	tests := []struct {
+		name               string
+		category           string
+		minPrice, maxPrice *pb.Money
+		sort               pb.ProductSort
+		pageSize           int32
+		want               []string
+		total              int32
+		facets             []string
+	}{
+		{name: "everything", want: []string{"MUG", "TEAPOT", "CANDLES", "HAT", "SUNGLASSES", "TANK"}, total: 6, facets: all},
+		{name: "category", category: "kitchen", want: []string{"MUG", "TEAPOT"}, total: 2, facets: all},
+		{name: "category with subcategories", category: "home", want: []string{"MUG", "TEAPOT", "CANDLES", "TANK"}, total: 4, facets: all},
+		{name: "category in any case", category: "ACCESSORIES", want: []string{"HAT", "SUNGLASSES"}, total: 2, facets: all},
+		{name: "unknown category", category: "garden", total: 0, facets: all},
+		{
+			name: "price range", minPrice: usd(12, 0), maxPrice: usd(19, 0),
+			want: []string{"CANDLES", "HAT", "TANK"}, total: 3,
+			facets: []string{"accessories:1", "clothing:1", "decor:2", "home:2", "tops:1"},
+		},
+		{
+			name: "price range counts facets of other categories", category: "kitchen", maxPrice: usd(10, 0),
+			want: []string{"MUG"}, total: 1, facets: []string{"home:1", "kitchen:1"},
+		},
+		{name: "minimum price in nanos", minPrice: usd(19, 990000000), want: []string{"TEAPOT", "SUNGLASSES"}, total: 2, facets: []string{"accessories:1", "home:1", "kitchen:1"}},
+		{name: "price without currency", maxPrice: &pb.Money{Units: 9}, want: []string{"MUG"}, total: 1, facets: []string{"home:1", "kitchen:1"}},
+		{name: "price ascending", sort: pb.ProductSort_PRODUCT_SORT_PRICE_ASC, want: []string{"MUG", "CANDLES", "HAT", "TANK", "SUNGLASSES", "TEAPOT"}, total: 6, facets: all},
+		{name: "price descending", sort: pb.ProductSort_PRODUCT_SORT_PRICE_DESC, want: []string{"TEAPOT", "SUNGLASSES", "TANK", "HAT", "CANDLES", "MUG"}, total: 6, facets: all},
+		{name: "name in any case", category: "home", sort: pb.ProductSort_PRODUCT_SORT_NAME, want: []string{"CANDLES", "MUG", "TANK", "TEAPOT"}, total: 4, facets: all},
+		{name: "page", sort: pb.ProductSort_PRODUCT_SORT_PRICE_ASC, pageSize: 2, want: []string{"MUG", "CANDLES"}, total: 6, facets: all},
+	}
+	c := newQueryCatalog(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := newProductQuery(tt.category, tt.minPrice, tt.maxPrice, tt.sort, tt.pageSize, "")
+			if err != nil {
+				t.Fatalf("newProductQuery: %v", err)
+			}
+			page := q.apply(c, c.Products(), nil)
+			if got := ids(page.products); !slices.Equal(got, tt.want) {
+				t.Errorf("products = %v, want %v", got, tt.want)
+			}
+			if page.totalSize != tt.total {
+				t.Errorf("total size = %d, want %d", page.totalSize, tt.total)
+			}
+			if got := facets(page); !slices.Equal(got, tt.facets) {
+				t.Errorf("facets = %v, want %v", got, tt.facets)
+			}
+		})
+	}
+}
+
+// scores stay with their products through filters, sorting and paging
+func TestProductQueryScores(t *testing.T) {
+	c := newQueryCatalog(t)
+	scores := []float64{6, 5, 4, 3, 2, 1}
+	q, err := newProductQuery("home", nil, nil, pb.ProductSort_PRODUCT_SORT_NAME, 3, "")
+	if err != nil {
+		t.Fatalf("newProductQuery: %v", err)
+	}
+	page := q.apply(c, c.Products(), scores)
+	if got := ids(page.products); !slices.Equal(got, []string{"CANDLES", "MUG", "TANK"}) || !slices.Equal(page.scores, []float64{4, 6, 1}) {
+		t.Fatalf("page = %v scored %v", got, page.scores)
+	}
+}
+
+func TestProductQueryPages(t *testing.T) {
+	var products []*pb.Product
+	for i := range 250 {
+		products = append(products, &pb.Product{Id: fmt.Sprintf("P%03d", i), PriceUsd: usd(1, 0)})
+	}
+	c, err := NewCatalog(products, nil)
+	if err != nil {
+		t.Fatalf("NewCatalog: %v", err)
+	}
+	tests := []struct {
+		name     string
+		pageSize int32
+		// sizes of the pages up to the last one
+		want []int
+	}{
+		{"default page size", 0, []int{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10}},
+		{"page size", 60, []int{60, 60, 60, 60, 10}},
+		{"maximum page size", 1000, []int{100, 100, 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sizes []int
+			var seen []string
+			token := ""
+			for {
+				q, err := newProductQuery("", nil, nil, pb.ProductSort_PRODUCT_SORT_RELEVANCE, tt.pageSize, token)
+				if err != nil {
+					t.Fatalf("newProductQuery: %v", err)
+				}
+				page := q.apply(c, c.Products(), nil)
+				if page.totalSize != 250 {
+					t.Fatalf("total size = %d, want 250", page.totalSize)
+				}
+				sizes = append(sizes, len(page.products))
+				seen = append(seen, ids(page.products)...)
+				if token = page.nextPageToken; token == "" {
+					break
+				}
+			}
+			if !slices.Equal(sizes, tt.want) {
+				t.Fatalf("page sizes = %v, want %v", sizes, tt.want)
+			}
+			if !slices.Equal(seen, ids(products)) {
+				t.Fatal("pages don't hold every product once, in order")
+			}
+		})
+	}
+
+	// a token past the end gives an empty last page
+	q, _ := newProductQuery("", nil, nil, 0, 10, pagetoken.Encode(300))
+	if page := q.apply(c, c.Products(), nil); len(page.products) != 0 || page.nextPageToken != "" {
+		t.Fatalf("page past the end = %d products, next token %q", len(page.products), page.nextPageToken)
+	}
+}
+
+func TestProductQueryErrors(t *testing.T) {
+	tests := []struct {
+		name               string
+		minPrice, maxPrice *pb.Money
+		sort               pb.ProductSort
+		pageSize           int32
+		pageToken          string
+	}{
+		{name: "unknown sort", sort: 99},
+		{name: "negative page size", pageSize: -1},
+		{name: "bad page token", pageToken: "nope!"},
+		{name: "negative page token", pageToken: pagetoken.Encode(-5)},
+		{name: "price in another currency", minPrice: &pb.Money{CurrencyCode: "EUR", Units: 1}},
+		{name: "invalid price", maxPrice: usd(1, -5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newProductQuery("", tt.minPrice, tt.maxPrice, tt.sort, tt.pageSize, tt.pageToken)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("newProductQuery = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	pb "productcatalogservice/proto"
+)
+
+// Weight of a term by the field it appears in
+const (
+	nameBoost        = 3.0
+	categoryBoost    = 2.0
+	descriptionBoost = 1.0
+)
+
+// How much a query term counts when it only matches an index term loosely
+const (
+	prefixFactor = 0.7
+	typo1Factor  = 0.6
+	typo2Factor  = 0.4
+)
+
+// Words too common to help ranking
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "for": true, "in": true,
+	"is": true, "it": true, "of": true, "on": true, "or": true, "the": true,
+	"this": true, "to": true, "with": true, "you": true, "your": true,
+}
+
+// a product containing a term, and the boosted number of occurrences
+type posting struct {
+	product int
+	weight  float64
+}
+
+// Inverted index over the name, categories and description of the products
+// of a catalog snapshot. Like the snapshot, it is never modified once built.
+type searchIndex struct {
+	products []*pb.Product
+	postings map[string][]posting
+	// vocabulary in alphabetical order, scanned for prefix and typo matches
+	terms []string
+}
+
+// index the products of a catalog
+func newSearchIndex(products []*pb.Product) *searchIndex {
+	idx := &searchIndex{products: products, postings: make(map[string][]posting)}
+	for i, p := range products {
+		weights := make(map[string]float64)
+		addField := func(text string, boost float64) {
+			for _, t := range tokenize(text) {
+				weights[t] += boost
+			}
+		}
+		addField(p.GetName(), nameBoost)
+		addField(strings.Join(p.GetCategories(), " "), categoryBoost)
+		addField(p.GetDescription(), descriptionBoost)
+		for t, w := range weights {
+			idx.postings[t] = append(idx.postings[t], posting{product: i, weight: w})
+		}
+	}
+	for t := range idx.postings {
+		idx.terms = append(idx.terms, t)
+	}
+	sort.Strings(idx.terms)
+	return idx
+}
+
+// Search ranks the products matching any word of the query, most relevant
+// first, and returns them with their scores. Each query word scores its best
+// match in a product, weighted by field and rarity (idf); loose matches on a
+// prefix or with typos count for less, and products matching more of the
+// query words rank higher. A blank query matches every product with score 0.
+func (idx *searchIndex) Search(query string) ([]*pb.Product, []float64) {
+	if strings.TrimSpace(query) == "" {
+		return idx.products, make([]float64, len(idx.products))
+	}
+	words := tokenize(query)
+	scores := make(map[int]float64)
+	matched := make(map[int]int)
+	for _, w := range words {
+		best := make(map[int]float64)
+		for term, factor := range idx.expand(w) {
+			postings := idx.postings[term]
+			idf := math.Log(1 + float64(len(idx.products))/float64(len(postings)))
+			for _, p := range postings {
+				best[p.product] = max(best[p.product], p.weight*idf*factor)
+			}
+		}
+		for product, score := range best {
+			scores[product] += score
+			matched[product]++
+		}
+	}
+
+	ranked := make([]int, 0, len(scores))
+	for product := range scores {
+		scores[product] *= float64(matched[product]) / float64(len(words))
+		ranked = append(ranked, product)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if scores[ranked[i]] != scores[ranked[j]] {
+			return scores[ranked[i]] > scores[ranked[j]]
+		}
+		return ranked[i] < ranked[j]
+	})
+
+	results := make([]*pb.Product, len(ranked))
+	out := make([]float64, len(ranked))
+	for i, product := range ranked {
+		results[i] = idx.products[product]
+		out[i] = scores[product]
+	}
+	return results, out
+}
+
+// index terms a query word matches, with how much each match counts
+func (idx *searchIndex) expand(word string) map[string]float64 {
+	out := make(map[string]float64)
+	if _, ok := idx.postings[word]; ok {
+		out[word] = 1
+	}
+	maxEdits := typoTolerance(word)
+	for _, term := range idx.terms {
+		if term == word {
+			continue
+		}
+		factor := 0.0
+		if len(word) >= 3 && strings.HasPrefix(term, word) {
+			factor = prefixFactor
+		}
+		if d := editDistance(word, term, maxEdits); d <= maxEdits {
+			switch d {
+			case 1:
+				factor = max(factor, typo1Factor)
+			case 2:
+				factor = max(factor, typo2Factor)
+			}
+		}
+		if factor > 0 {
+			out[term] = factor
+		}
+	}
+	return out
+}
+
+// number of typos allowed in a word: none for short words, where a single
+// edit already gives a different word
+func typoTolerance(word string) int {
+	switch n := len([]rune(word)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// split text into lowercase, stemmed words, dropping stop words
+func tokenize(text string) []string {
+	var out []string
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, f := range fields {
+		if stopWords[f] {
+			continue
+		}
+		out = append(out, stem(f))
+	}
+	return out
+}
+
+// light English suffix stripping, so that e.g. "mugs" and "mug" or "holders"
+// and "holder" index the same; catalog and queries go through the same rules
+func stem(w string) string {
+	switch {
+	case len(w) > 4 && strings.HasSuffix(w, "ies"):
+		return w[:len(w)-3] + "y"
+	case len(w) > 4 && (strings.HasSuffix(w, "sses") || strings.HasSuffix(w, "xes") ||
+		strings.HasSuffix(w, "ches") || strings.HasSuffix(w, "shes")):
+		return w[:len(w)-2]
+	case len(w) > 3 && strings.HasSuffix(w, "s") &&
+		!strings.HasSuffix(w, "ss") && !strings.HasSuffix(w, "us"):
+		return w[:len(w)-1]
+	case len(w) > 5 && strings.HasSuffix(w, "ing"):
+		return w[:len(w)-3]
+	}
+	return w
+}
+
+// edit distance between a and b counting insertions, deletions, substitutions
+// and swaps of adjacent letters, or limit+1 when it exceeds limit
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > limit || -d > limit {
+		return limit + 1
+	}
+	// rows i-2, i-1 and i of the distance matrix
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return min(prev[len(rb)], limit+1)
+}
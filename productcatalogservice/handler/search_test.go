@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	pb "productcatalogservice/proto"
+)
+
+var searchCatalog = []*pb.Product{
+	{Id: "MUG", Name: "Mug", Categories: []string{"kitchen"}, Description: "A sturdy ceramic mug in blue."},
+	{Id: "BLUEHAT", Name: "Blue Hat", Categories: []string{"accessories"}, Description: "A wool hat."},
+	{Id: "SUNGLASSES", Name: "Sunglasses", Categories: []string{"accessories"}, Description: "Keep the sun out of your eyes."},
+	{Id: "TEAPOT", Name: "Teapot", Categories: []string{"kitchen"}, Description: "Pours well into mugs."},
+	{Id: "CANDLES", Name: "Candle Holders", Categories: []string{"home", "decor"}, Description: "A pair of brass holders."},
+	{Id: "BLUEMUG", Name: "Blue Mug", Categories: []string{"kitchen"}, Description: "Two mugs for your coffee."},
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name, query string
+		// IDs of the products found, best first
+		want []string
+	}{
+		{"both words rank first", "blue mugs", []string{"BLUEMUG", "MUG", "BLUEHAT", "TEAPOT"}},
+		{"name outranks description", "mug", []string{"MUG", "BLUEMUG", "TEAPOT"}},
+		{"name outranks description for colors", "blue", []string{"BLUEHAT", "BLUEMUG", "MUG"}},
+		{"rare word outranks common one", "hat mug", []string{"BLUEHAT", "MUG", "BLUEMUG", "TEAPOT"}},
+		{"category", "kitchen", []string{"MUG", "TEAPOT", "BLUEMUG"}},
+		{"stemmed plural", "candle holders", []string{"CANDLES"}},
+		{"case and punctuation", "CANDLE, holder!", []string{"CANDLES"}},
+		{"prefix", "tea", []string{"TEAPOT"}},
+		{"short prefix", "blu", []string{"BLUEHAT", "BLUEMUG", "MUG"}},
+		{"prefix outranks exact description match", "sun", []string{"SUNGLASSES"}},
+		{"one typo", "sunglases", []string{"SUNGLASSES"}},
+		{"swapped letters", "candel", []string{"CANDLES"}},
+		{"typo and plural", "sunglasss", []string{"SUNGLASSES"}},
+		{"no typos in short words", "mog", nil},
+		{"stop words only", "the", nil},
+		{"no match", "zzz", nil},
+		{"blank query", "  ", []string{"MUG", "BLUEHAT", "SUNGLASSES", "TEAPOT", "CANDLES", "BLUEMUG"}},
+	}
+	idx := newSearchIndex(searchCatalog)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products, scores := idx.Search(tt.query)
+			var got []string
+			for _, p := range products {
+				got = append(got, p.GetId())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+			if len(scores) != len(products) || !slices.IsSortedFunc(scores, func(a, b float64) int { return cmp.Compare(b, a) }) {
+				t.Fatalf("Search(%q) scores = %v, want one per product, highest first", tt.query, scores)
+			}
+		})
+	}
+}
+
+// a full match scores more than a partial one, and an exact match more than a typo
+func TestSearchScores(t *testing.T) {
+	idx := newSearchIndex(searchCatalog)
+	score := func(query string) float64 {
+		t.Helper()
+		_, scores := idx.Search(query)
+		if len(scores) == 0 {
+			t.Fatalf("Search(%q) found nothing", query)
+		}
+		return scores[0]
+	}
+	if exact, typo := score("sunglasses"), score("sunglases"); exact <= typo {
+		t.Errorf("exact match scores %v, a typo %v", exact, typo)
+	}
+	if typo1, typo2 := score("sunglases"), score("sunglsases"); typo1 <= typo2 {
+		t.Errorf("one typo scores %v, two %v", typo1, typo2)
+	}
+	if full, partial := score("candle holders"), score("candle socks"); full <= partial {
+		t.Errorf("full match scores %v, a partial one %v", full, partial)
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct{ word, want string }{
+		{"mugs", "mug"},
+		{"mug", "mug"},
+		{"holders", "holder"},
+		{"batteries", "battery"},
+		{"glasses", "glass"},
+		{"boxes", "box"},
+		{"watches", "watch"},
+		{"dishes", "dish"},
+		{"glass", "glass"},
+		{"cactus", "cactus"},
+		{"bus", "bus"},
+		{"holding", "hold"},
+		{"ring", "ring"},
+	}
+	for _, tt := range tests {
+		if got := stem(tt.word); got != tt.want {
+			t.Errorf("stem(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		limit int
+		want  int
+	}{
+		{"mug", "mug", 2, 0},
+		{"sunglases", "sunglass", 2, 1},
+		{"candel", "candle", 2, 1},
+		{"mgu", "mug", 2, 1},
+		{"kitchen", "kitten", 2, 2},
+		{"kitchen", "kitten", 1, 2},
+		{"mug", "teapot", 2, 3},
+		{"", "ab", 2, 2},
+		{"café", "cafe", 1, 1},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.limit); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.limit, got, tt.want)
+		}
+	}
+}
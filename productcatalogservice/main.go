@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"os"
 	handler "productcatalogservice/handler"
 	pb "productcatalogservice/proto"
 	"strconv"
@@ -14,6 +16,18 @@ import (
 const PORT = 50015
 const ADDRESS = "127.0.0.1"
 
+// Product catalog file, reloaded on SIGUSR1
+const CATALOG_PATH = "data/products.json"
+
+// Category tree, reloaded along with the catalog
+const CATEGORIES_PATH = "data/categories.json"
+
+// Bearer token of the catalog admin RPCs, disabled when unset
+const ADMIN_TOKEN_ENV = "CATALOG_ADMIN_TOKEN"
+
+// Stock and pending reservations
+const INVENTORY_PATH = "data/inventory.json"
+
 func main() {
 	ipport := ADDRESS + ":" + strconv.Itoa(PORT)
 	// -------------Register on consul---------------
@@ -42,12 +56,30 @@ func main() {
 		return
 	}
 
+	// load the product catalog
+	catalog, err_catalog := handler.NewProductCatalogService(CATALOG_PATH, CATEGORIES_PATH)
+	if err_catalog != nil {
+		fmt.Println("product catalog init error:", err_catalog)
+		return
+	}
+	catalog.AdminToken = os.Getenv(ADMIN_TOKEN_ENV)
+	go catalog.WatchSignals(context.Background())
+
+	// load the inventory
+	inventory, err_inventory := handler.NewInventory(INVENTORY_PATH)
+	if err_inventory != nil {
+		fmt.Println("inventory init error:", err_inventory)
+		return
+	}
+	catalog.Inventory = inventory
+
 	//-----------------------grpc code--------------------------------
 	// init grpc server
 	grpcServer := grpc.NewServer()
 
 	// register grpc service
-	pb.RegisterProductCatalogServiceServer(grpcServer, new(handler.ProductCatalogService))
+	pb.RegisterProductCatalogServiceServer(grpcServer, catalog)
+	pb.RegisterInventoryServiceServer(grpcServer, inventory)
 
 	// start grpc listen
 	listen, err := net.Listen("tcp", ipport)
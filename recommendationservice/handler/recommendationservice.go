@@ -15,6 +15,9 @@ var (
 	logger = log.New(&buf, "logger: ", log.Lshortfile)
 )
 
+// Products fetched per ListProducts call, the most the catalog returns at once
+const catalogPageSize = 100
+
 type RecommendationService struct {
 	ProductCatalogService pb.ProductCatalogServiceClient
 }
@@ -23,17 +26,22 @@ type RecommendationService struct {
 func (s *RecommendationService) ListRecommendations(ctx context.Context, in *pb.ListRecommendationsRequest) (out *pb.ListRecommendationsResponse, e error) {
 	maxResponsesCount := 5
 	out = new(pb.ListRecommendationsResponse)
-	// search product catalog
-	catalog, err := s.ProductCatalogService.ListProducts(ctx, &pb.Empty{})
-	if err != nil {
-		return out, err
-	}
-	filteredProductsIDs := make([]string, 0, len(catalog.Products))
-	for _, p := range catalog.Products {
-		if contains(p.Id, in.ProductIds) {
-			continue
+	// search product catalog, a page at a time
+	var filteredProductsIDs []string
+	for token := ""; ; {
+		catalog, err := s.ProductCatalogService.ListProducts(ctx, &pb.ListProductsRequest{PageSize: catalogPageSize, PageToken: token})
+		if err != nil {
+			return out, err
+		}
+		for _, p := range catalog.Products {
+			if contains(p.Id, in.ProductIds) {
+				continue
+			}
+			filteredProductsIDs = append(filteredProductsIDs, p.Id)
+		}
+		if token = catalog.NextPageToken; token == "" {
+			break
 		}
-		filteredProductsIDs = append(filteredProductsIDs, p.Id)
 	}
 	productIDs := sample(filteredProductsIDs, maxResponsesCount)
 	logger.Printf("[Recv ListRecommendations] product_ids=%v", productIDs)
@@ -0,0 +1,3 @@
+module recordlog
+
+go 1.22.0
@@ -0,0 +1,273 @@
+// Package recordlog implements the append-only log the file-backed stores keep
+// their data in. Each line of the log is "<crc32> <encoded record>"; records
+// are fsynced before they are applied, replayed in order on open, and a torn
+// or corrupt tail left by a crash is cut off. An append that fails is cut off
+// right away, so later records don't land behind a torn line. Once enough
+// records pile up, the log is rewritten from a snapshot of the store.
+package recordlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Records appended after which the log is compacted, unless configured otherwise
+const DefaultCompactThreshold = 1024
+
+// Log
+var (
+	buf    bytes.Buffer
+	logger = log.New(&buf, "logger: ", log.Lshortfile)
+)
+
+// Codec turns records into the payload of a log line and back
+type Codec[T any] struct {
+	Marshal   func(T) ([]byte, error)
+	Unmarshal func([]byte) (T, error)
+}
+
+// JSON encodes records with encoding/json
+func JSON[T any]() Codec[T] {
+	return Codec[T]{
+		Marshal: func(rec T) ([]byte, error) {
+			return json.Marshal(rec)
+		},
+		Unmarshal: func(data []byte) (T, error) {
+			var rec T
+			err := json.Unmarshal(data, &rec)
+			return rec, err
+		},
+	}
+}
+
+// Config describes the records of a log and how the store uses them
+type Config[T any] struct {
+	// what the log holds, e.g. "cart log", for errors and log messages
+	Name  string
+	Codec Codec[T]
+	// Apply applies a record to the store, on replay and after each append
+	Apply func(T) error
+	// Snapshot emits records that rebuild the current state of the store
+	Snapshot func(emit func(T) error) error
+	// DefaultCompactThreshold when 0
+	CompactThreshold int
+}
+
+// Log is an append-only record log. It isn't safe for concurrent use: the
+// store serializes its calls, and Apply and Snapshot run under its lock.
+type Log[T any] struct {
+	Config[T]
+	path      string
+	file      *os.File
+	records   int
+	compactAt int
+	// end of the last durable record
+	size int64
+	// set when a failed append couldn't be cut off; the next append rewrites
+	// the log from a snapshot first
+	broken error
+}
+
+// Open replays the log at path into the store, creating it and its directory if needed
+func Open[T any](path string, cfg Config[T]) (*Log[T], error) {
+	if cfg.CompactThreshold <= 0 {
+		cfg.CompactThreshold = DefaultCompactThreshold
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create %s directory: %w", cfg.Name, err)
+	}
+	l := &Log[T]{Config: cfg, path: path, compactAt: cfg.CompactThreshold}
+	if err := l.replay(); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", l.Name, err)
+	}
+	l.file = f
+	return l, nil
+}
+
+// Append writes a record, flushes it to disk and applies it, then compacts the log when it is due
+func (l *Log[T]) Append(rec T) error {
+	if l.broken != nil {
+		if err := l.Compact(); err != nil {
+			return fmt.Errorf("%s is broken: %w", l.Name, l.broken)
+		}
+		l.broken = nil
+	}
+	line, err := l.encode(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(line); err != nil {
+		return l.abort(fmt.Errorf("write %s: %w", l.Name, err))
+	}
+	if err := l.file.Sync(); err != nil {
+		return l.abort(fmt.Errorf("sync %s: %w", l.Name, err))
+	}
+	l.size += int64(len(line))
+	if err := l.Apply(rec); err != nil {
+		return err
+	}
+	l.records++
+	if l.records >= l.compactAt {
+		// the record is already durable, a failed compaction only costs disk space
+		if err := l.Compact(); err != nil {
+			logger.Printf("Failed to compact %s: %v", l.Name, err)
+		}
+	}
+	return nil
+}
+
+// cut a failed append off the log, so that the next one starts on a fresh
+// line; when that fails too, the log is broken until it is rewritten
+func (l *Log[T]) abort(err error) error {
+	if terr := l.file.Truncate(l.size); terr != nil {
+		l.broken = err
+		logger.Printf("Failed to cut a failed append off %s: %v", l.Name, terr)
+	}
+	return err
+}
+
+// Compact rewrites the log from a snapshot of the store, replacing the old
+// file atomically. The new file is written through the handle later appends
+// use, so nothing needs to be opened once it is in place.
+func (l *Log[T]) Compact() error {
+	tmpPath := l.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	records := 0
+	var size int64
+	w := bufio.NewWriter(tmp)
+	err = l.Snapshot(func(rec T) error {
+		line, err := l.encode(rec)
+		if err != nil {
+			return err
+		}
+		records++
+		size += int64(len(line))
+		_, err = w.Write(line)
+		return err
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, l.path)
+	}
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	syncDir(filepath.Dir(l.path))
+
+	l.file.Close()
+	l.file = tmp
+	l.size = size
+	l.records = records
+	// keep the threshold relative to the live data so large stores don't compact on every write
+	l.compactAt = max(l.CompactThreshold, 2*records)
+	return nil
+}
+
+// Close the log file
+func (l *Log[T]) Close() error {
+	return l.file.Close()
+}
+
+// replay the log into the store, truncating a torn tail
+func (l *Log[T]) replay() error {
+	f, err := os.OpenFile(l.path, os.O_RDWR, 0)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open %s: %w", l.Name, err)
+	}
+	defer f.Close()
+
+	var valid int64
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read %s: %w", l.Name, err)
+		}
+		rec, ok := l.decode(line)
+		if !ok {
+			break
+		}
+		if err := l.Apply(rec); err != nil {
+			return err
+		}
+		valid += int64(len(line))
+		l.records++
+	}
+	l.size = valid
+
+	if info, err := f.Stat(); err == nil && info.Size() > valid {
+		logger.Printf("Truncating corrupt %s tail at offset %d", l.Name, valid)
+		if err := f.Truncate(valid); err != nil {
+			return fmt.Errorf("truncate %s: %w", l.Name, err)
+		}
+		return f.Sync()
+	}
+	return nil
+}
+
+// encode a record as a checksummed log line
+func (l *Log[T]) encode(rec T) ([]byte, error) {
+	data, err := l.Codec.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.IndexByte(data, '\n') >= 0 {
+		return nil, fmt.Errorf("%s record spans several lines", l.Name)
+	}
+	return []byte(fmt.Sprintf("%08x %s\n", crc32.ChecksumIEEE(data), data)), nil
+}
+
+// decode a log line, reporting false when it is torn or corrupt
+func (l *Log[T]) decode(line []byte) (T, bool) {
+	var zero T
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	sum, data, found := bytes.Cut(line, []byte(" "))
+	if !found {
+		return zero, false
+	}
+	var want uint32
+	if _, err := fmt.Sscanf(string(sum), "%08x", &want); err != nil || crc32.ChecksumIEEE(data) != want {
+		return zero, false
+	}
+	rec, err := l.Codec.Unmarshal(data)
+	if err != nil {
+		return zero, false
+	}
+	return rec, true
+}
+
+// flush a directory entry change (rename) to disk
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+}
@@ -0,0 +1,243 @@
+package recordlog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A record setting a key of the test store, or deleting it when N is 0
+type entry struct {
+	Key string `json:"key"`
+	N   int    `json:"n"`
+}
+
+// A store of counters kept in a log
+type store struct {
+	t    *testing.T
+	path string
+	data map[string]int
+	log  *Log[entry]
+}
+
+func openStore(t *testing.T, path string, threshold int) *store {
+	t.Helper()
+	s := &store{t: t, path: path, data: make(map[string]int)}
+	l, err := Open(path, Config[entry]{
+		Name:  "test log",
+		Codec: JSON[entry](),
+		Apply: func(e entry) error {
+			if e.N == 0 {
+				delete(s.data, e.Key)
+			} else {
+				s.data[e.Key] = e.N
+			}
+			return nil
+		},
+		Snapshot: func(emit func(entry) error) error {
+			for k, n := range s.data {
+				if err := emit(entry{k, n}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		CompactThreshold: threshold,
+	})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	s.log = l
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *store) set(key string, n int) {
+	s.t.Helper()
+	if err := s.log.Append(entry{key, n}); err != nil {
+		s.t.Fatalf("Append(%s, %d): %v", key, n, err)
+	}
+}
+
+func (s *store) reopen(threshold int) *store {
+	s.t.Helper()
+	if err := s.log.Close(); err != nil {
+		s.t.Fatalf("Close: %v", err)
+	}
+	return openStore(s.t, s.path, threshold)
+}
+
+func (s *store) want(want map[string]int) {
+	s.t.Helper()
+	if len(s.data) != len(want) {
+		s.t.Fatalf("store = %v, want %v", s.data, want)
+	}
+	for k, n := range want {
+		if s.data[k] != n {
+			s.t.Fatalf("store = %v, want %v", s.data, want)
+		}
+	}
+}
+
+// lines of the log file
+func lines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+func appendRaw(t *testing.T, path, data string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "test.log")
+	s := openStore(t, path, 0)
+	s.set("a", 1)
+	s.set("b", 2)
+	s.set("a", 3)
+	s.set("b", 0)
+	s = s.reopen(0)
+	s.want(map[string]int{"a": 3})
+
+	// and appends after a replay are kept too
+	s.set("c", 4)
+	s = s.reopen(0)
+	s.want(map[string]int{"a": 3, "c": 4})
+	if n := len(lines(t, path)); n != 5 {
+		t.Fatalf("log has %d lines, want 5", n)
+	}
+}
+
+func TestReplayCutsTornTail(t *testing.T) {
+	tests := []struct {
+		name string
+		tail string
+	}{
+		{"half a line", `1234abcd {"key":"x"`},
+		{"bad checksum", "00000000 {\"key\":\"x\",\"n\":9}\n"},
+		{"no checksum", "{\"key\":\"x\",\"n\":9}\n"},
+		{"bad record", "00000000 nonsense\n"},
+		{"empty line", "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.log")
+			s := openStore(t, path, 0)
+			s.set("a", 1)
+			s.log.Close()
+			appendRaw(t, path, tt.tail)
+
+			s = openStore(t, path, 0)
+			s.want(map[string]int{"a": 1})
+			if n := len(lines(t, path)); n != 1 {
+				t.Fatalf("log has %d lines after replay, want the tail cut off", n)
+			}
+			s.set("b", 2)
+			s = s.reopen(0)
+			s.want(map[string]int{"a": 1, "b": 2})
+		})
+	}
+}
+
+// a failed append is cut off, so the records after it survive a restart
+func TestFailedAppendIsCutOff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	s := openStore(t, path, 0)
+	s.set("a", 1)
+	// a write that stopped halfway
+	appendRaw(t, path, `1234abcd {"key":"to`)
+	if err := s.log.abort(errors.New("disk full")); err == nil {
+		t.Fatal("abort returned no error")
+	}
+	if s.log.broken != nil {
+		t.Fatalf("log is broken: %v", s.log.broken)
+	}
+	s.set("b", 2)
+	s.set("c", 3)
+	s = s.reopen(0)
+	s.want(map[string]int{"a": 1, "b": 2, "c": 3})
+}
+
+// a log whose failed append couldn't be cut off is rewritten before the next one
+func TestBrokenLogIsRewritten(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	s := openStore(t, path, 0)
+	s.set("a", 1)
+	appendRaw(t, path, `1234abcd {"key":"to`)
+	s.log.broken = errors.New("disk full")
+
+	s.set("b", 2)
+	if s.log.broken != nil {
+		t.Fatal("log still broken after a rewrite")
+	}
+	s = s.reopen(0)
+	s.want(map[string]int{"a": 1, "b": 2})
+}
+
+func TestCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	s := openStore(t, path, 4)
+	for i := range 10 {
+		s.set("a", i+1)
+	}
+	s.set("b", 1)
+	// compacted down to one record at the 4th, 7th and 10th append, and b appended since
+	if n := len(lines(t, path)); n != 2 {
+		t.Fatalf("log has %d lines, want 2", n)
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("temporary file left behind: %v", err)
+	}
+
+	// appends after a compaction go to the new file and survive a restart
+	s = s.reopen(4)
+	s.want(map[string]int{"a": 10, "b": 1})
+	if err := s.log.Compact(); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	s.set("c", 1)
+	s = s.reopen(4)
+	s.want(map[string]int{"a": 10, "b": 1, "c": 1})
+	if n := len(lines(t, path)); n != 3 {
+		t.Fatalf("log has %d lines, want 3", n)
+	}
+}
+
+func TestAppendRejectsMultilineRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	l, err := Open(path, Config[string]{
+		Name:     "raw log",
+		Codec:    Codec[string]{Marshal: func(s string) ([]byte, error) { return []byte(s), nil }, Unmarshal: func(b []byte) (string, error) { return string(b), nil }},
+		Apply:    func(string) error { return nil },
+		Snapshot: func(func(string) error) error { return nil },
+	})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer l.Close()
+	if err := l.Append("two\nlines"); err == nil {
+		t.Fatal("Append of a multiline record succeeded")
+	}
+	if err := l.Append("one line"); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if bytes.Count(data, []byte("\n")) != 1 {
+		t.Fatalf("log = %q, want one line", data)
+	}
+}
@@ -0,0 +1,454 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "shippingservice/proto"
+)
+
+// Furthest a misspelt country or state name may be from the real one, in
+// edits, to be suggested as its correction
+const maxSuggestionDistance = 2
+
+// Address rules of a country
+type countryRules struct {
+	// ISO 3166-1 alpha-2 code, used in normalized addresses
+	code string
+	// other names it goes by, matched case-insensitively
+	names []string
+	// postal code format without spaces and hyphens, nil for no postal codes
+	postal *regexp.Regexp
+	// writes a valid postal code the usual way from its compact form, nil to keep it compact
+	formatPostal func(compact string) string
+	// whether addresses may go without a postal code (e.g. IE)
+	postalOptional bool
+	// digits of a legacy numeric zip_code, whose leading zeros were lost; 0
+	// when postal codes have letters and a zip_code can't hold them
+	zipDigits int
+	// state codes to names; a country with states requires one
+	states map[string]string
+	// whether English street abbreviations apply
+	english bool
+}
+
+// Usual postal code formats
+var (
+	fourDigits = regexp.MustCompile(`^\d{4}$`)
+	fiveDigits = regexp.MustCompile(`^\d{5}$`)
+)
+
+// Countries whose addresses are checked; other countries are shipped to as
+// given, unless their name looks like a misspelling of one of these
+var countries = []*countryRules{
+	{code: "US", names: []string{"USA", "United States", "United States of America"}, postal: regexp.MustCompile(`^\d{5}(\d{4})?$`), formatPostal: splitAfter(5, "-"), zipDigits: 5, states: usStates, english: true},
+	{code: "CA", names: []string{"Canada"}, postal: regexp.MustCompile(`^[A-Z]\d[A-Z]\d[A-Z]\d$`), formatPostal: splitAfter(3, " "), states: caProvinces, english: true},
+	{code: "MX", names: []string{"Mexico"}, postal: fiveDigits, zipDigits: 5},
+	{code: "GB", names: []string{"UK", "United Kingdom", "Great Britain"}, postal: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]?\d[A-Z]{2}$`), formatPostal: splitBefore(3, " "), english: true},
+	{code: "IE", names: []string{"Ireland"}, postal: regexp.MustCompile(`^[A-Z]\d[\dW][A-Z\d]{4}$`), formatPostal: splitAfter(3, " "), postalOptional: true, english: true},
+	{code: "DE", names: []string{"Germany", "Deutschland"}, postal: fiveDigits, zipDigits: 5},
+	{code: "FR", names: []string{"France"}, postal: fiveDigits, zipDigits: 5},
+	{code: "IT", names: []string{"Italy", "Italia"}, postal: fiveDigits, zipDigits: 5},
+	{code: "ES", names: []string{"Spain", "España"}, postal: fiveDigits, zipDigits: 5},
+	{code: "NL", names: []string{"Netherlands", "The Netherlands", "Holland"}, postal: regexp.MustCompile(`^\d{4}[A-Z]{2}$`), formatPostal: splitAfter(4, " ")},
+	{code: "BE", names: []string{"Belgium"}, postal: fourDigits, zipDigits: 4},
+	{code: "AT", names: []string{"Austria", "Österreich"}, postal: fourDigits, zipDigits: 4},
+	{code: "CH", names: []string{"Switzerland", "Schweiz", "Suisse"}, postal: fourDigits, zipDigits: 4},
+	{code: "SE", names: []string{"Sweden", "Sverige"}, postal: fiveDigits, formatPostal: splitAfter(3, " "), zipDigits: 5},
+	{code: "PL", names: []string{"Poland", "Polska"}, postal: fiveDigits, formatPostal: splitAfter(2, "-"), zipDigits: 5},
+	{code: "JP", names: []string{"Japan"}, postal: regexp.MustCompile(`^\d{7}$`), formatPostal: splitAfter(3, "-"), zipDigits: 7},
+	{code: "AU", names: []string{"Australia"}, postal: fourDigits, zipDigits: 4, states: auStates, english: true},
+}
+
+var usStates = map[string]string{
+	"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas", "CA": "California",
+	"CO": "Colorado", "CT": "Connecticut", "DE": "Delaware", "DC": "District of Columbia",
+	"FL": "Florida", "GA": "Georgia", "HI": "Hawaii", "ID": "Idaho", "IL": "Illinois",
+	"IN": "Indiana", "IA": "Iowa", "KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana",
+	"ME": "Maine", "MD": "Maryland", "MA": "Massachusetts", "MI": "Michigan", "MN": "Minnesota",
+	"MS": "Mississippi", "MO": "Missouri", "MT": "Montana", "NE": "Nebraska", "NV": "Nevada",
+	"NH": "New Hampshire", "NJ": "New Jersey", "NM": "New Mexico", "NY": "New York",
+	"NC": "North Carolina", "ND": "North Dakota", "OH": "Ohio", "OK": "Oklahoma", "OR": "Oregon",
+	"PA": "Pennsylvania", "RI": "Rhode Island", "SC": "South Carolina", "SD": "South Dakota",
+	"TN": "Tennessee", "TX": "Texas", "UT": "Utah", "VT": "Vermont", "VA": "Virginia",
+	"WA": "Washington", "WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+	"PR": "Puerto Rico",
+}
+
+var caProvinces = map[string]string{
+	"AB": "Alberta", "BC": "British Columbia", "MB": "Manitoba", "NB": "New Brunswick",
+	"NL": "Newfoundland and Labrador", "NS": "Nova Scotia", "NT": "Northwest Territories",
+	"NU": "Nunavut", "ON": "Ontario", "PE": "Prince Edward Island", "QC": "Quebec",
+	"SK": "Saskatchewan", "YT": "Yukon",
+}
+
+var auStates = map[string]string{
+	"ACT": "Australian Capital Territory", "NSW": "New South Wales", "NT": "Northern Territory",
+	"QLD": "Queensland", "SA": "South Australia", "TAS": "Tasmania", "VIC": "Victoria",
+	"WA": "Western Australia",
+}
+
+// Usual abbreviations of street suffixes in English-speaking countries
+var streetSuffixes = map[string]string{
+	"street": "St", "avenue": "Ave", "road": "Rd", "boulevard": "Blvd", "drive": "Dr",
+	"lane": "Ln", "court": "Ct", "place": "Pl", "terrace": "Ter", "highway": "Hwy",
+	"parkway": "Pkwy", "square": "Sq", "circle": "Cir",
+}
+
+// Usual abbreviations of unit designators in English-speaking countries
+var unitDesignators = map[string]string{
+	"suite": "Ste", "apartment": "Apt", "building": "Bldg", "floor": "Fl",
+}
+
+// Validate Address
+func (s *ShippingService) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest) (out *pb.ValidateAddressResponse, e error) {
+	logger.Print("[ValidateAddress] Request received")
+	defer logger.Print("[ValidateAddress] Request completed")
+	return validateAddress(in.Address), nil
+}
+
+// validateAddress normalizes an address and lists what keeps it from being
+// shipped to, with a correction when a misspelt country or state explains it
+func validateAddress(address *pb.Address) *pb.ValidateAddressResponse {
+	out := new(pb.ValidateAddressResponse)
+	a := normalizeAddress(address)
+	out.Normalized = a
+	suggestion := proto.Clone(a).(*pb.Address)
+	corrected := false
+	issue := func(field, format string, args ...any) {
+		out.Issues = append(out.Issues, &pb.AddressIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if a.AddressLine_1 == "" {
+		issue("address_line_1", "street address is required")
+	}
+	if a.City == "" {
+		issue("city", "city is required")
+	}
+	if a.CountryCode == "" {
+		issue("country_code", "country is required")
+	}
+
+	rules := countryOf(a.CountryCode)
+	if rules == nil && a.CountryCode != "" {
+		if c, ok := closestCountry(a.CountryCode); ok {
+			issue("country_code", "unknown country %q", a.CountryCode)
+			// from the request, so that a legacy zip_code gets the leading zeros of the country
+			suggestion = proto.Clone(address).(*pb.Address)
+			suggestion.CountryCode = c.code
+			suggestion = normalizeAddress(suggestion)
+			corrected = true
+			rules = c
+		} else if !isCountryCode(a.CountryCode) {
+			issue("country_code", "unknown country %q, expected its two-letter ISO code", a.CountryCode)
+		}
+	}
+	if rules != nil {
+		if len(rules.states) > 0 {
+			if _, ok := rules.states[suggestion.Region]; !ok {
+				if suggestion.Region == "" {
+					issue("region", "state is required in %s", rules.code)
+				} else if code, ok := closestState(rules, suggestion.Region); ok {
+					issue("region", "unknown state %q in %s", a.Region, rules.code)
+					suggestion.Region = code
+					corrected = true
+				} else {
+					issue("region", "unknown state %q in %s", a.Region, rules.code)
+				}
+			}
+		}
+		if rules.postal != nil {
+			if suggestion.PostalCode == "" {
+				if !rules.postalOptional {
+					issue("postal_code", "postal code is required in %s", rules.code)
+				}
+			} else if !rules.postal.MatchString(compactPostal(suggestion.PostalCode)) {
+				issue("postal_code", "invalid postal code %q for %s", suggestion.PostalCode, rules.code)
+			}
+		}
+	}
+
+	out.Valid = len(out.Issues) == 0
+	if corrected {
+		fillLegacy(suggestion, rules)
+		out.Suggestions = append(out.Suggestions, suggestion)
+	}
+	return out
+}
+
+// issues of an address as one line, e.g. for an error message
+func issuesText(issues []*pb.AddressIssue) string {
+	msgs := make([]string, len(issues))
+	for i, issue := range issues {
+		msgs[i] = issue.Field + ": " + issue.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// normalizeAddress trims the fields of an address, fixes their case and
+// applies the usual country, state, postal code and street formats. Both its
+// new and legacy fields are set, whichever ones the client sent.
+func normalizeAddress(address *pb.Address) *pb.Address {
+	a := upgradeAddress(address)
+	a.AddressLine_1 = fixCase(cleanSpaces(a.AddressLine_1))
+	a.AddressLine_2 = fixCase(cleanSpaces(a.AddressLine_2))
+	a.City = fixCase(cleanSpaces(a.City))
+	a.Region = cleanSpaces(a.Region)
+	a.CountryCode = cleanSpaces(a.CountryCode)
+	a.PostalCode = strings.ToUpper(cleanSpaces(a.PostalCode))
+
+	rules := countryOf(a.CountryCode)
+	if rules == nil {
+		if isCountryCode(a.CountryCode) {
+			a.CountryCode = strings.ToUpper(a.CountryCode)
+		}
+		a.Region = fixCase(a.Region)
+		fillLegacy(a, nil)
+		return a
+	}
+	a.CountryCode = rules.code
+	if code, ok := stateCode(rules, a.Region); ok {
+		a.Region = code
+	} else {
+		a.Region = fixCase(a.Region)
+	}
+	if compact := compactPostal(a.PostalCode); rules.postal != nil && rules.postal.MatchString(compact) {
+		a.PostalCode = compact
+		if rules.formatPostal != nil {
+			a.PostalCode = rules.formatPostal(compact)
+		}
+	}
+	if rules.english {
+		a.AddressLine_1 = abbreviateStreet(a.AddressLine_1)
+		a.AddressLine_2 = abbreviateStreet(a.AddressLine_2)
+	}
+	fillLegacy(a, rules)
+	return a
+}
+
+// upgradeAddress copies an address, filling the new fields a legacy client
+// left empty from their legacy counterparts. A legacy zip_code gets back the
+// leading zeros the number lost.
+func upgradeAddress(address *pb.Address) *pb.Address {
+	a := new(pb.Address)
+	if address != nil {
+		a = proto.Clone(address).(*pb.Address)
+	}
+	if a.AddressLine_1 == "" && a.AddressLine_2 == "" {
+		a.AddressLine_1 = a.StreetAddress
+	}
+	if a.Region == "" {
+		a.Region = a.State
+	}
+	if a.CountryCode == "" {
+		a.CountryCode = a.Country
+	}
+	if a.PostalCode == "" && a.ZipCode > 0 {
+		digits := 0
+		if rules := countryOf(cleanSpaces(a.CountryCode)); rules != nil {
+			digits = rules.zipDigits
+		}
+		a.PostalCode = fmt.Sprintf("%0*d", digits, a.ZipCode)
+	}
+	return a
+}
+
+// fillLegacy sets the legacy fields of a normalized address from the new
+// ones, for clients that only read those
+func fillLegacy(a *pb.Address, rules *countryRules) {
+	a.StreetAddress = a.AddressLine_1
+	if a.AddressLine_2 != "" {
+		a.StreetAddress += ", " + a.AddressLine_2
+	}
+	a.State = a.Region
+	a.Country = a.CountryCode
+	a.ZipCode = 0
+	if rules != nil && rules.zipDigits > 0 {
+		// the leading digits, e.g. 2139 for the US postal code 02139-4307
+		compact := compactPostal(a.PostalCode)
+		if len(compact) >= rules.zipDigits {
+			if zip, err := strconv.ParseInt(compact[:rules.zipDigits], 10, 32); err == nil {
+				a.ZipCode = int32(zip)
+			}
+		}
+	}
+}
+
+// a postal code in upper case without spaces and hyphens
+func compactPostal(postal string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(postal))
+}
+
+// writes a compact postal code with sep after its first n characters, when it is longer
+func splitAfter(n int, sep string) func(string) string {
+	return func(compact string) string {
+		if len(compact) <= n {
+			return compact
+		}
+		return compact[:n] + sep + compact[n:]
+	}
+}
+
+// writes a compact postal code with sep before its last n characters
+func splitBefore(n int, sep string) func(string) string {
+	return func(compact string) string {
+		return compact[:len(compact)-n] + sep + compact[len(compact)-n:]
+	}
+}
+
+// whether a country is given as a two-letter code
+func isCountryCode(country string) bool {
+	return len(country) == 2 && strings.IndexFunc(country, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z')
+	}) < 0
+}
+
+// rules of a country by code or name, nil for countries that aren't checked
+func countryOf(name string) *countryRules {
+	for _, c := range countries {
+		if strings.EqualFold(c.code, name) {
+			return c
+		}
+		for _, n := range c.names {
+			if strings.EqualFold(n, name) {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// code of a state of a country given by code or name
+func stateCode(c *countryRules, state string) (string, bool) {
+	if _, ok := c.states[strings.ToUpper(state)]; ok {
+		return strings.ToUpper(state), true
+	}
+	for code, name := range c.states {
+		if strings.EqualFold(name, state) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// the country whose name is closest to a misspelt one; codes and other short
+// names are too close to each other to tell apart
+func closestCountry(name string) (*countryRules, bool) {
+	var best *countryRules
+	bestDist := maxSuggestionDistance + 1
+	if len(name) <= 3 {
+		return nil, false
+	}
+	for _, c := range countries {
+		for _, n := range c.names {
+			if len(n) <= 3 {
+				continue
+			}
+			if d := editDistance(strings.ToLower(n), strings.ToLower(name)); d < bestDist {
+				best, bestDist = c, d
+			}
+		}
+	}
+	return best, best != nil
+}
+
+// the code of the state of a country whose name is closest to a misspelt one
+func closestState(c *countryRules, name string) (string, bool) {
+	best := ""
+	bestDist := maxSuggestionDistance + 1
+	for code, n := range c.states {
+		d := editDistance(strings.ToLower(n), strings.ToLower(name))
+		if d < bestDist || (d == bestDist && code < best) {
+			best, bestDist = code, d
+		}
+	}
+	return best, best != ""
+}
+
+// Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// trim a field and collapse its runs of spaces
+func cleanSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// title-case a field typed all in lower or upper case, leaving words with
+// digits (e.g. "5th") and mixed-case fields as they are
+func fixCase(s string) string {
+	if s != strings.ToLower(s) && s != strings.ToUpper(s) {
+		return s
+	}
+	words := strings.Fields(s)
+	for i, w := range words {
+		if strings.IndexFunc(w, unicode.IsDigit) >= 0 {
+			continue
+		}
+		r := []rune(strings.ToLower(w))
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// abbreviate the street suffix ending the street name of an address line and
+// its unit designators, e.g. "12 West Lane Street, Suite 200" to "12 West Lane
+// St, Ste 200". Suffix words elsewhere are part of the name and kept.
+func abbreviateStreet(s string) string {
+	words := strings.Fields(s)
+	// the street name ends at the first unit designator followed by a unit
+	end := len(words)
+	for i := len(words) - 2; i >= 0; i-- {
+		if isUnit(words[i+1]) {
+			if abbr, ok := abbreviateWord(words[i], unitDesignators); ok {
+				words[i] = abbr
+				end = i
+			}
+		}
+	}
+	if end > 1 {
+		words[end-1], _ = abbreviateWord(words[end-1], streetSuffixes)
+	}
+	return strings.Join(words, " ")
+}
+
+// a word's abbreviation, keeping a comma after it and dropping its period
+func abbreviateWord(w string, abbreviations map[string]string) (string, bool) {
+	word := strings.TrimRight(w, ".,")
+	abbr, ok := abbreviations[strings.ToLower(word)]
+	if !ok {
+		return w, false
+	}
+	return abbr + strings.Trim(w[len(word):], "."), true
+}
+
+// whether a word is a unit number or letter, e.g. "200", "4B" or "C"
+func isUnit(w string) bool {
+	w = strings.TrimRight(w, ".,")
+	return strings.IndexFunc(w, unicode.IsDigit) >= 0 || len([]rune(w)) == 1
+}
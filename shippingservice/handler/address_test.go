@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	pb "shippingservice/proto"
+)
+
+func TestAbbreviateStreet(t *testing.T) {
+	tests := []struct{ line, want string }{
+		{"12 Main Street", "12 Main St"},
+		{"12 Main Street.", "12 Main St"},
+		{"12 West Lane Street", "12 West Lane St"},
+		{"12 North Main Street", "12 North Main St"},
+		{"1 Court Road", "1 Court Rd"},
+		{"12 West Lane Street, Suite 200", "12 West Lane St, Ste 200"},
+		{"5 Park Avenue Apartment 4B", "5 Park Ave Apt 4B"},
+		{"Suite 200", "Ste 200"},
+		{"Building C, Floor 3", "Bldg C, Fl 3"},
+		{"12 Floor Street", "12 Floor St"},
+		{"1 Avenue of the Americas", "1 Avenue of the Americas"},
+		{"10 Downing Street North", "10 Downing Street North"},
+		{"Street", "Street"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := abbreviateStreet(tt.line); got != tt.want {
+			t.Errorf("abbreviateStreet(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.Address
+		// new fields of the normalized address
+		want *pb.Address
+	}{
+		{
+			name: "us address",
+			in:   &pb.Address{AddressLine_1: "  1600 amphitheatre   parkway ", City: "MOUNTAIN VIEW", Region: "california", CountryCode: "united states", PostalCode: "940431351"},
+			want: &pb.Address{AddressLine_1: "1600 Amphitheatre Pkwy", City: "Mountain View", Region: "CA", CountryCode: "US", PostalCode: "94043-1351"},
+		},
+		{
+			name: "mixed case kept",
+			in:   &pb.Address{AddressLine_1: "1 McDonald Drive", AddressLine_2: "suite 5", City: "San Francisco", Region: "ca", CountryCode: "us", PostalCode: "94105"},
+			want: &pb.Address{AddressLine_1: "1 McDonald Dr", AddressLine_2: "Ste 5", City: "San Francisco", Region: "CA", CountryCode: "US", PostalCode: "94105"},
+		},
+		{
+			name: "uk postal code",
+			in:   &pb.Address{AddressLine_1: "10 Downing Street", City: "London", CountryCode: "UK", PostalCode: "sw1a2aa"},
+			want: &pb.Address{AddressLine_1: "10 Downing St", City: "London", CountryCode: "GB", PostalCode: "SW1A 2AA"},
+		},
+		{
+			name: "no abbreviations outside english-speaking countries",
+			in:   &pb.Address{AddressLine_1: "Hauptstraße 5", City: "berlin", CountryCode: "Deutschland", PostalCode: "10115"},
+			want: &pb.Address{AddressLine_1: "Hauptstraße 5", City: "Berlin", CountryCode: "DE", PostalCode: "10115"},
+		},
+		{
+			name: "polish postal code",
+			in:   &pb.Address{AddressLine_1: "ul. Marszałkowska 1", City: "Warszawa", CountryCode: "PL", PostalCode: "00950"},
+			want: &pb.Address{AddressLine_1: "ul. Marszałkowska 1", City: "Warszawa", CountryCode: "PL", PostalCode: "00-950"},
+		},
+		{
+			name: "invalid postal code kept",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "MA", CountryCode: "US", PostalCode: "0213"},
+			want: &pb.Address{AddressLine_1: "1 Main St", City: "Boston", Region: "MA", CountryCode: "US", PostalCode: "0213"},
+		},
+		{
+			name: "unchecked country",
+			in:   &pb.Address{AddressLine_1: "1 rue de la paix", City: "monaco", Region: "monte carlo", CountryCode: "mc", PostalCode: "98000"},
+			want: &pb.Address{AddressLine_1: "1 Rue De La Paix", City: "Monaco", Region: "Monte Carlo", CountryCode: "MC", PostalCode: "98000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newFields(normalizeAddress(tt.in))
+			if want := newFields(tt.want); got != want {
+				t.Fatalf("normalizeAddress = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+// the new fields of an address, to compare them at a glance
+func newFields(a *pb.Address) string {
+	return fmt.Sprintf("%q, %q, %q, %q, %q, %q", a.GetAddressLine_1(), a.GetAddressLine_2(), a.GetCity(), a.GetRegion(), a.GetCountryCode(), a.GetPostalCode())
+}
+
+func TestValidateAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.Address
+		// fields with issues, in order
+		issues []string
+		// new fields of the suggested correction, if any
+		suggestion string
+	}{
+		{
+			name: "valid",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "MA", CountryCode: "US", PostalCode: "02139"},
+		},
+		{
+			name:   "empty",
+			in:     &pb.Address{},
+			issues: []string{"address_line_1", "city", "country_code"},
+		},
+		{
+			name:   "missing state and postal code",
+			in:     &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", CountryCode: "US"},
+			issues: []string{"region", "postal_code"},
+		},
+		{
+			name:   "invalid postal code",
+			in:     &pb.Address{AddressLine_1: "1 Main Street", City: "Toronto", Region: "ON", CountryCode: "CA", PostalCode: "12345"},
+			issues: []string{"postal_code"},
+		},
+		{
+			name: "optional postal code",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", City: "Galway", CountryCode: "Ireland"},
+		},
+		{
+			name: "unchecked country code",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", City: "Monaco", CountryCode: "MC"},
+		},
+		{
+			name:   "unknown country",
+			in:     &pb.Address{AddressLine_1: "1 Main Street", City: "Atlantis", CountryCode: "Atlantis"},
+			issues: []string{"country_code"},
+		},
+		{
+			name:       "misspelt state",
+			in:         &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "Masachusets", CountryCode: "US", PostalCode: "02139"},
+			issues:     []string{"region"},
+			suggestion: `"1 Main St", "", "Boston", "MA", "US", "02139"`,
+		},
+		{
+			name:       "misspelt country",
+			in:         &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "massachusetts", CountryCode: "Untied States", PostalCode: "021394307"},
+			issues:     []string{"country_code"},
+			suggestion: `"1 Main St", "", "Boston", "MA", "US", "02139-4307"`,
+		},
+		{
+			name:       "misspelt country and state",
+			in:         &pb.Address{AddressLine_1: "1 Main Street", City: "Sydney", Region: "New South Wale", CountryCode: "Austrailia", PostalCode: "2000"},
+			issues:     []string{"country_code", "region"},
+			suggestion: `"1 Main St", "", "Sydney", "NSW", "AU", "2000"`,
+		},
+		{
+			name:   "state too far from any",
+			in:     &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "Nowhere", CountryCode: "US", PostalCode: "02139"},
+			issues: []string{"region"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := validateAddress(tt.in)
+			var issues []string
+			for _, issue := range out.Issues {
+				issues = append(issues, issue.Field)
+			}
+			if !slices.Equal(issues, tt.issues) {
+				t.Fatalf("issues = %s, want %v", issuesText(out.Issues), tt.issues)
+			}
+			if out.Valid != (len(tt.issues) == 0) {
+				t.Fatalf("valid = %v with issues %v", out.Valid, issues)
+			}
+			var suggestions []string
+			for _, s := range out.Suggestions {
+				suggestions = append(suggestions, newFields(s))
+			}
+			if tt.suggestion == "" && len(suggestions) != 0 || tt.suggestion != "" && !slices.Equal(suggestions, []string{tt.suggestion}) {
+				t.Fatalf("suggestions = %v, want %q", suggestions, tt.suggestion)
+			}
+		})
+	}
+}
+
+func TestUpgradeAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.Address
+		// new fields after the upgrade
+		want *pb.Address
+	}{
+		{
+			name: "legacy fields",
+			in:   &pb.Address{StreetAddress: "1 Main Street", City: "Boston", State: "MA", Country: "US", ZipCode: 2139},
+			want: &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "MA", CountryCode: "US", PostalCode: "02139"},
+		},
+		{
+			name: "zip code of a country given by name",
+			in:   &pb.Address{Country: " united states ", ZipCode: 501},
+			want: &pb.Address{CountryCode: " united states ", PostalCode: "00501"},
+		},
+		{
+			name: "zip code of another length",
+			in:   &pb.Address{Country: "PL", ZipCode: 950},
+			want: &pb.Address{CountryCode: "PL", PostalCode: "00950"},
+		},
+		{
+			name: "zip code of an unchecked country",
+			in:   &pb.Address{Country: "MC", ZipCode: 98000},
+			want: &pb.Address{CountryCode: "MC", PostalCode: "98000"},
+		},
+		{
+			name: "zip code without a country",
+			in:   &pb.Address{ZipCode: 2139},
+			want: &pb.Address{PostalCode: "2139"},
+		},
+		{
+			name: "new fields win",
+			in: &pb.Address{
+				AddressLine_1: "2 New Road", Region: "NY", CountryCode: "US", PostalCode: "10001",
+				StreetAddress: "1 Old Street", State: "MA", Country: "CA", ZipCode: 2139,
+			},
+			want: &pb.Address{AddressLine_1: "2 New Road", Region: "NY", CountryCode: "US", PostalCode: "10001"},
+		},
+		{
+			name: "second line keeps the street address out",
+			in:   &pb.Address{AddressLine_2: "Suite 5", StreetAddress: "1 Old Street"},
+			want: &pb.Address{AddressLine_2: "Suite 5"},
+		},
+		{
+			name: "nil address",
+			want: &pb.Address{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := newFields(upgradeAddress(tt.in)), newFields(tt.want); got != want {
+				t.Fatalf("upgradeAddress = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+// the legacy fields of an address, to compare them at a glance
+func legacyFields(a *pb.Address) string {
+	return fmt.Sprintf("%q, %q, %q, %d", a.GetStreetAddress(), a.GetState(), a.GetCountry(), a.GetZipCode())
+}
+
+func TestLegacyFields(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.Address
+		// legacy fields of the normalized address
+		want string
+	}{
+		{
+			name: "zip code with leading zeros",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "MA", CountryCode: "US", PostalCode: "02139-4307"},
+			want: `"1 Main St", "MA", "US", 2139`,
+		},
+		{
+			name: "second line",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", AddressLine_2: "suite 5", City: "Tokyo", CountryCode: "Japan", PostalCode: "1000001"},
+			want: `"1 Main Street, Suite 5", "", "JP", 1000001`,
+		},
+		{
+			name: "postal code with letters",
+			in:   &pb.Address{AddressLine_1: "10 Downing Street", City: "London", CountryCode: "GB", PostalCode: "SW1A 2AA"},
+			want: `"10 Downing St", "", "GB", 0`,
+		},
+		{
+			name: "invalid postal code",
+			in:   &pb.Address{AddressLine_1: "1 Main Street", City: "Boston", Region: "MA", CountryCode: "US", PostalCode: "02A39"},
+			want: `"1 Main St", "MA", "US", 0`,
+		},
+		{
+			name: "unchecked country",
+			in:   &pb.Address{AddressLine_1: "1 Rue Grimaldi", City: "Monaco", CountryCode: "MC", PostalCode: "98000"},
+			want: `"1 Rue Grimaldi", "", "MC", 0`,
+		},
+		{
+			name: "legacy round trip",
+			in:   &pb.Address{StreetAddress: "1 main street", City: "boston", State: "massachusetts", Country: "USA", ZipCode: 2139},
+			want: `"1 Main St", "MA", "US", 2139`,
+		},
+		{
+			name: "new fields win",
+			in: &pb.Address{
+				AddressLine_1: "2 New Road", City: "New York", Region: "NY", CountryCode: "US", PostalCode: "10001",
+				StreetAddress: "1 Old Street", State: "MA", Country: "CA", ZipCode: 2139,
+			},
+			want: `"2 New Rd", "NY", "US", 10001`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := validateAddress(tt.in)
+			if got := legacyFields(out.Normalized); got != tt.want {
+				t.Fatalf("legacy fields = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// suggested corrections have their legacy fields set from the corrected ones
+func TestSuggestionLegacyFields(t *testing.T) {
+	out := validateAddress(&pb.Address{StreetAddress: "1 Main Street", City: "Warszawa", Country: "Polnad", ZipCode: 950})
+	if len(out.Suggestions) != 1 {
+		t.Fatalf("suggestions = %v, want one", out.Suggestions)
+	}
+	s := out.Suggestions[0]
+	if got, want := newFields(s), `"1 Main Street", "", "Warszawa", "", "PL", "00-950"`; got != want {
+		t.Fatalf("suggestion = %s, want %s", got, want)
+	}
+	if got, want := legacyFields(s), `"1 Main Street", "", "PL", 950`; got != want {
+		t.Fatalf("suggestion legacy fields = %s, want %s", got, want)
+	}
+}
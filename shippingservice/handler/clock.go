@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"shippingservice/shipmentstore"
+
+	pb "shippingservice/proto"
+)
+
+// Times of day, in UTC, at which the simulated carriers pick parcels up on
+// their dispatch day, and take them out for delivery and deliver them on the
+// earliest delivery day
+const (
+	pickupHour         = 10
+	outForDeliveryHour = 8
+	deliveryHour       = 14
+)
+
+// Returned by an update that leaves a shipment as it was
+var errUnchanged = errors.New("shipment unchanged")
+
+// CarrierClock simulates the carriers: every Interval it moves the active
+// shipments along their schedule, as tracking updates from a real carrier
+// would. Shipments in EXCEPTION wait for an UpdateShipmentStatus.
+type CarrierClock struct {
+	Shipments shipmentstore.ShipmentStore
+	Interval  time.Duration
+	// defaults to time.Now
+	Clock func() time.Time
+	// called after every tick with the number of shipments moved along
+	OnTick func(advanced int, err error)
+}
+
+// Tick moves every active shipment along its schedule once and returns how many changed
+func (c *CarrierClock) Tick(ctx context.Context) (int, error) {
+	now := time.Now
+	if c.Clock != nil {
+		now = c.Clock
+	}
+	ids, err := c.Shipments.Active(ctx)
+	if err != nil {
+		return 0, err
+	}
+	advanced := 0
+	var errs []error
+	for _, id := range ids {
+		_, err := c.Shipments.Update(ctx, id, func(shipment *pb.Shipment) error {
+			if !advance(shipment, now()) {
+				return errUnchanged
+			}
+			return nil
+		})
+		switch {
+		case err == nil:
+			advanced++
+		case !errors.Is(err, errUnchanged):
+			errs = append(errs, fmt.Errorf("advance shipment %s: %w", id, err))
+		}
+	}
+	return advanced, errors.Join(errs...)
+}
+
+// Run ticks every Interval until ctx is done
+func (c *CarrierClock) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			advanced, err := c.Tick(ctx)
+			if c.OnTick != nil {
+				c.OnTick(advanced, err)
+			}
+		}
+	}
+}
+
+// advance a shipment through every step due by now, each event dated when it
+// was due; reports whether the shipment changed
+func advance(shipment *pb.Shipment, now time.Time) bool {
+	changed := false
+	for {
+		next, due, ok := nextStep(shipment)
+		if !ok || now.Before(due) {
+			return changed
+		}
+		if last := shipment.GetUpdatedAt().AsTime(); due.Before(last) {
+			due = last
+		}
+		if transition(shipment, next, due, "") != nil {
+			return changed
+		}
+		changed = true
+	}
+}
+
+// the scheduled next status of a shipment and when it is due, false when the
+// carrier doesn't move it by itself
+func nextStep(shipment *pb.Shipment) (pb.ShipmentStatus, time.Time, bool) {
+	delivery := shipment.GetEarliestDelivery().AsTime()
+	switch shipment.Status {
+	case pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED:
+		return pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, shipment.GetDispatchAt().AsTime().Add(pickupHour * time.Hour), true
+	case pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT:
+		return pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY, delivery.Add(outForDeliveryHour * time.Hour), true
+	case pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY:
+		return pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED, delivery.Add(deliveryHour * time.Hour), true
+	}
+	return 0, time.Time{}, false
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	pb "shippingservice/proto"
+)
+
+func TestCarrierClock(t *testing.T) {
+	dispatch := shipDay.Truncate(24 * time.Hour)
+	delivery := dispatch.AddDate(0, 0, 2)
+	tests := []struct {
+		name string
+		// status set before the ticks, LABEL_CREATED when unset
+		status pb.ShipmentStatus
+		// times of the ticks, and the events expected after the last one with their times
+		ticks      []time.Time
+		want       []pb.ShipmentStatus
+		wantTimes  []time.Time
+		wantActive bool
+	}{
+		{
+			name:       "before pickup",
+			ticks:      []time.Time{dispatch.Add(pickupHour*time.Hour - time.Second)},
+			want:       []pb.ShipmentStatus{labelCreated},
+			wantTimes:  []time.Time{shipDay},
+			wantActive: true,
+		},
+		{
+			name:       "picked up",
+			ticks:      []time.Time{dispatch.Add(pickupHour * time.Hour)},
+			want:       []pb.ShipmentStatus{labelCreated, inTransit},
+			wantTimes:  []time.Time{shipDay, dispatch.Add(pickupHour * time.Hour)},
+			wantActive: true,
+		},
+		{
+			name:       "out for delivery",
+			ticks:      []time.Time{dispatch.Add(11 * time.Hour), delivery.Add(9 * time.Hour)},
+			want:       []pb.ShipmentStatus{labelCreated, inTransit, outForDelivery},
+			wantTimes:  []time.Time{shipDay, dispatch.Add(pickupHour * time.Hour), delivery.Add(outForDeliveryHour * time.Hour)},
+			wantActive: true,
+		},
+		{
+			name:      "every step due in one tick, dated when due",
+			ticks:     []time.Time{delivery.AddDate(0, 0, 1)},
+			want:      []pb.ShipmentStatus{labelCreated, inTransit, outForDelivery, delivered},
+			wantTimes: []time.Time{shipDay, dispatch.Add(pickupHour * time.Hour), delivery.Add(outForDeliveryHour * time.Hour), delivery.Add(deliveryHour * time.Hour)},
+		},
+		{
+			name:       "exception waits for an update",
+			status:     exception,
+			ticks:      []time.Time{delivery.AddDate(0, 0, 1)},
+			want:       []pb.ShipmentStatus{labelCreated},
+			wantTimes:  []time.Time{shipDay},
+			wantActive: true,
+		},
+		{
+			name:   "canceled",
+			status: canceled,
+			ticks:  []time.Time{delivery.AddDate(0, 0, 1)},
+			want:   []pb.ShipmentStatus{labelCreated},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			shipment := testShipment("T1")
+			if tt.status != 0 {
+				shipment.Status = tt.status
+			}
+			store := newTestShipments(t, shipment)
+			var now time.Time
+			clock := &CarrierClock{Shipments: store, Clock: func() time.Time { return now }}
+			for _, now = range tt.ticks {
+				if _, err := clock.Tick(ctx); err != nil {
+					t.Fatalf("Tick at %v: %v", now, err)
+				}
+			}
+			got, err := store.Get(ctx, "T1")
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if statuses := eventStatuses(got); !slices.Equal(statuses, tt.want) {
+				t.Fatalf("events = %v, want %v", statuses, tt.want)
+			}
+			for i, want := range tt.wantTimes {
+				if at := got.Events[i].Time.AsTime(); !at.Equal(want) {
+					t.Errorf("event %s at %v, want %v", got.Events[i].Status, at, want)
+				}
+			}
+			active, _ := store.Active(ctx)
+			if (len(active) == 1) != tt.wantActive {
+				t.Fatalf("active shipments = %v, want T1 active %v", active, tt.wantActive)
+			}
+		})
+	}
+}
+
+// a pickup recorded after its due time, e.g. by an update, dates the next steps no earlier
+func TestCarrierClockKeepsEventsInOrder(t *testing.T) {
+	ctx := context.Background()
+	shipment := testShipment("T1")
+	late := shipment.GetEarliestDelivery().AsTime().Add(12 * time.Hour)
+	if err := transition(shipment, exception, shipDay.Add(time.Hour), ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := transition(shipment, inTransit, late, ""); err != nil {
+		t.Fatal(err)
+	}
+	store := newTestShipments(t, shipment)
+	now := late.Add(time.Hour)
+	clock := &CarrierClock{Shipments: store, Clock: func() time.Time { return now }}
+	if n, err := clock.Tick(ctx); n != 1 || err != nil {
+		t.Fatalf("Tick = %d, %v, want 1 shipment moved", n, err)
+	}
+	got, _ := store.Get(ctx, "T1")
+	if last := got.Events[len(got.Events)-1]; last.Status != outForDelivery || !last.Time.AsTime().Equal(late) {
+		t.Fatalf("last event %s at %v, want OUT_FOR_DELIVERY at %v", last.Status, last.Time.AsTime(), late)
+	}
+	// nothing more is due until delivery time
+	if n, err := clock.Tick(ctx); n != 0 || err != nil {
+		t.Fatalf("second Tick = %d, %v, want nothing moved", n, err)
+	}
+}
+
+func TestCarrierClockTicks(t *testing.T) {
+	ctx := context.Background()
+	store := newTestShipments(t, testShipment("T1"), testShipment("T2"), testShipment("T3"))
+	if _, err := store.Update(ctx, "T3", func(s *pb.Shipment) error { return transition(s, canceled, shipDay, "") }); err != nil {
+		t.Fatal(err)
+	}
+	now := shipDay.Truncate(24 * time.Hour).Add(pickupHour * time.Hour)
+	ticked := make(chan int, 1)
+	clock := &CarrierClock{
+		Shipments: store,
+		Interval:  time.Millisecond,
+		Clock:     func() time.Time { return now },
+		OnTick: func(advanced int, err error) {
+			if err != nil {
+				t.Errorf("tick: %v", err)
+			}
+			select {
+			case ticked <- advanced:
+			default:
+			}
+		},
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		clock.Run(ctx)
+		close(done)
+	}()
+	if n := <-ticked; n != 2 {
+		t.Errorf("first tick moved %d shipments, want 2", n)
+	}
+	cancel()
+	<-done
+}
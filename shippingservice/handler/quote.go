@@ -2,30 +2,62 @@ package handler
 
 import (
 	"fmt"
-	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "shippingservice/proto"
 )
 
-// currency quote type
+// A shipping option: the price and delivery window of a carrier service to one zone
 type Quote struct {
-	Dollars uint32
-	Cents   uint32
+	Carrier string
+	// service level, e.g. "express"
+	Level string
+	// service name, e.g. "Next Day Air"
+	Name string
+	Zone string
+	// price in USD cents
+	Cents int64
+	// delivery window, both days at UTC midnight
+	Earliest time.Time
+	Latest   time.Time
+}
+
+// option ID, e.g. "ups-express"
+func (q Quote) ID() string {
+	return optionID(q.Carrier, q.Level)
+}
+
+// shipping option ID of a carrier service
+func optionID(carrier, level string) string {
+	return strings.ToLower(carrier) + "-" + level
 }
 
 // quote
 func (q Quote) String() string {
-	return fmt.Sprintf("$%d.%d", q.Dollars, q.Cents)
+	return fmt.Sprintf("$%d.%02d", q.Cents/100, q.Cents%100)
 }
 
-// create quote based on the count of items
-func CreateQuoteFromCount(count int) Quote {
-	return CreateQuoteFromFloat(8.99)
+// price as a USD amount
+func (q Quote) Money() *pb.Money {
+	return &pb.Money{
+		CurrencyCode: "USD",
+		Units:        q.Cents / 100,
+		Nanos:        int32(q.Cents%100) * 10000000,
+	}
 }
 
-// create quote
-func CreateQuoteFromFloat(value float64) Quote {
-	units, fraction := math.Modf(value)
-	return Quote{
-		uint32(units),
-		uint32(math.Trunc(fraction * 100)),
+// shipping option message
+func (q Quote) Option() *pb.ShippingOption {
+	return &pb.ShippingOption{
+		Id:               q.ID(),
+		Carrier:          q.Carrier,
+		ServiceLevel:     q.Level,
+		Name:             q.Name,
+		CostUsd:          q.Money(),
+		EarliestDelivery: timestamppb.New(q.Earliest),
+		LatestDelivery:   timestamppb.New(q.Latest),
 	}
 }
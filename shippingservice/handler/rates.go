@@ -0,0 +1,317 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"money"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Returned when no carrier ships a parcel to a zone
+var ErrNoRate = errors.New("no carrier ships this parcel to this destination")
+
+// Returned for a shipping option ID no carrier offers to a zone
+var ErrUnknownOption = errors.New("unknown shipping option")
+
+// Layout of the holiday dates
+const dateLayout = "2006-01-02"
+
+// RateTable holds the shipping zones and the rates carriers charge to each,
+// as loaded from a json file. It is not modified once loaded.
+type RateTable struct {
+	// zone of the countries no zone lists
+	DefaultZone string `json:"default_zone"`
+	// days nothing is picked up or delivered on, as YYYY-MM-DD
+	Holidays []string   `json:"holidays"`
+	Zones    []*Zone    `json:"zones"`
+	Carriers []*Carrier `json:"carriers"`
+
+	byCountry map[string]*Zone
+	byName    map[string]*Zone
+	holidays  map[string]bool
+}
+
+// A group of destination countries sharing rates
+type Zone struct {
+	Name string `json:"name"`
+	// country codes and names, matched case-insensitively
+	Countries []string `json:"countries"`
+	// orders worth at least this many USD cents ship free, 0 for never
+	FreeOverCents int64 `json:"free_over_cents"`
+}
+
+// A carrier and its rates by zone name; zones it doesn't list aren't served
+type Carrier struct {
+	Name string `json:"name"`
+	// cm³ per kg of dimensional weight, 0 to charge the actual weight only
+	DimDivisor int64 `json:"dim_divisor"`
+	// whether it delivers on Saturdays
+	Saturdays bool                  `json:"saturdays"`
+	Services  []*Service            `json:"services"`
+	Zones     map[string]*ZoneRates `json:"zones"`
+}
+
+// A service level of a carrier, priced as a share of its zone rates
+type Service struct {
+	// "standard" or "express", unique per carrier
+	Level string `json:"level"`
+	Name  string `json:"name"`
+	// of the zone rate, 100 to charge the rate itself
+	PricePercent int64 `json:"price_percent"`
+	// fewest and most business days in transit by zone name; zones it doesn't
+	// list aren't served at this level
+	TransitDays map[string][2]int `json:"transit_days"`
+}
+
+// Weight brackets of a carrier in a zone, lightest first. A parcel pays the
+// first bracket its weight fits in; above the last one, each started kg adds
+// ExtraPerKgCents, or the parcel isn't served when that is 0.
+type ZoneRates struct {
+	Brackets        []RateBracket `json:"brackets"`
+	ExtraPerKgCents int64         `json:"extra_per_kg_cents"`
+}
+
+// Price in USD cents of parcels up to MaxGrams, inclusive
+type RateBracket struct {
+	MaxGrams   int64 `json:"max_grams"`
+	PriceCents int64 `json:"price_cents"`
+}
+
+// What a shipment weighs and the room it takes
+type Parcel struct {
+	Grams     int64
+	VolumeMm3 int64
+}
+
+// Load a rate table from a json file
+func LoadRateTable(path string) (*RateTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rate table: %w", err)
+	}
+	t := new(RateTable)
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("failed to parse rate table: %w", err)
+	}
+	if err := t.index(); err != nil {
+		return nil, fmt.Errorf("invalid rate table: %w", err)
+	}
+	return t, nil
+}
+
+// validate the table and index zones by name and country
+func (t *RateTable) index() error {
+	t.byCountry = make(map[string]*Zone)
+	t.byName = make(map[string]*Zone)
+	for _, z := range t.Zones {
+		if _, ok := t.byName[z.Name]; ok || z.Name == "" {
+			return fmt.Errorf("duplicate or empty zone name %q", z.Name)
+		}
+		t.byName[z.Name] = z
+		for _, c := range z.Countries {
+			key := strings.ToLower(strings.TrimSpace(c))
+			if other, ok := t.byCountry[key]; ok {
+				return fmt.Errorf("country %s is in zones %s and %s", c, other.Name, z.Name)
+			}
+			t.byCountry[key] = z
+		}
+	}
+	if _, ok := t.byName[t.DefaultZone]; !ok {
+		return fmt.Errorf("unknown default zone %q", t.DefaultZone)
+	}
+	t.holidays = make(map[string]bool)
+	for _, d := range t.Holidays {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return fmt.Errorf("invalid holiday %q", d)
+		}
+		t.holidays[d] = true
+	}
+	for _, c := range t.Carriers {
+		levels := make(map[string]bool)
+		for _, svc := range c.Services {
+			if svc.Level == "" || levels[svc.Level] {
+				return fmt.Errorf("duplicate or empty service level %q of carrier %s", svc.Level, c.Name)
+			}
+			levels[svc.Level] = true
+			if svc.PricePercent <= 0 {
+				return fmt.Errorf("service %s of carrier %s must have a positive price percent", svc.Level, c.Name)
+			}
+			for name, days := range svc.TransitDays {
+				if _, ok := c.Zones[name]; !ok {
+					return fmt.Errorf("service %s of carrier %s has transit days for zone %q it has no rates for", svc.Level, c.Name, name)
+				}
+				if days[0] < 0 || days[1] < days[0] {
+					return fmt.Errorf("invalid transit days %v of carrier %s %s in zone %s", days, c.Name, svc.Level, name)
+				}
+			}
+		}
+		for name, rates := range c.Zones {
+			if _, ok := t.byName[name]; !ok {
+				return fmt.Errorf("carrier %s has rates for unknown zone %q", c.Name, name)
+			}
+			if len(rates.Brackets) == 0 {
+				return fmt.Errorf("carrier %s has no rates for zone %s", c.Name, name)
+			}
+			for i, b := range rates.Brackets {
+				if b.PriceCents < 0 || (i > 0 && b.MaxGrams <= rates.Brackets[i-1].MaxGrams) {
+					return fmt.Errorf("brackets of carrier %s in zone %s must be sorted by weight, with positive prices", c.Name, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Zone of a destination country, the default zone for unlisted ones
+func (t *RateTable) ZoneOf(country string) *Zone {
+	if z, ok := t.byCountry[strings.ToLower(strings.TrimSpace(country))]; ok {
+		return z
+	}
+	return t.byName[t.DefaultZone]
+}
+
+// Options returns every service that ships a parcel to a country, cheapest
+// first, then soonest delivered. Orders of at least the free-shipping threshold
+// of the zone ship free at the standard level; delivery windows count business
+// days from the next business day after now.
+func (t *RateTable) Options(country string, p Parcel, orderValue money.Money, now time.Time) ([]Quote, error) {
+	zone := t.ZoneOf(country)
+	free := false
+	if zone.FreeOverCents > 0 {
+		threshold := money.Money{CurrencyCode: "USD", Units: zone.FreeOverCents / 100, Nanos: int32(zone.FreeOverCents%100) * 10000000}
+		if c, err := money.Compare(orderValue, threshold); err == nil && c >= 0 {
+			free = true
+		}
+	}
+	dispatch := t.DispatchDay(now)
+
+	var options []Quote
+	for _, c := range t.Carriers {
+		cents, ok := c.price(zone.Name, p)
+		if !ok {
+			continue
+		}
+		for _, svc := range c.Services {
+			days, ok := svc.TransitDays[zone.Name]
+			if !ok {
+				continue
+			}
+			q := Quote{
+				Carrier:  c.Name,
+				Level:    svc.Level,
+				Name:     svc.Name,
+				Zone:     zone.Name,
+				Cents:    cents * svc.PricePercent / 100,
+				Earliest: t.addBusinessDays(dispatch, days[0], c.Saturdays),
+				Latest:   t.addBusinessDays(dispatch, days[1], c.Saturdays),
+			}
+			if free && svc.Level == "standard" {
+				q.Cents = 0
+			}
+			options = append(options, q)
+		}
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("%w: %d g to %s", ErrNoRate, p.Grams, zone.Name)
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		if options[i].Cents != options[j].Cents {
+			return options[i].Cents < options[j].Cents
+		}
+		return options[i].Latest.Before(options[j].Latest)
+	})
+	return options, nil
+}
+
+// Option returns the service of a shipping option ID to a country with its
+// delivery window from now, unpriced
+func (t *RateTable) Option(id, country string, now time.Time) (Quote, error) {
+	zone := t.ZoneOf(country)
+	for _, c := range t.Carriers {
+		for _, svc := range c.Services {
+			if optionID(c.Name, svc.Level) != id {
+				continue
+			}
+			days, ok := svc.TransitDays[zone.Name]
+			if !ok {
+				return Quote{}, fmt.Errorf("%w: %s doesn't ship to %s", ErrUnknownOption, id, zone.Name)
+			}
+			dispatch := t.DispatchDay(now)
+			return Quote{
+				Carrier:  c.Name,
+				Level:    svc.Level,
+				Name:     svc.Name,
+				Zone:     zone.Name,
+				Earliest: t.addBusinessDays(dispatch, days[0], c.Saturdays),
+				Latest:   t.addBusinessDays(dispatch, days[1], c.Saturdays),
+			}, nil
+		}
+	}
+	return Quote{}, fmt.Errorf("%w: %q", ErrUnknownOption, id)
+}
+
+// DispatchDay is the first business day after now, at UTC midnight; parcels
+// aren't picked up on Saturdays
+func (t *RateTable) DispatchDay(now time.Time) time.Time {
+	y, m, d := now.UTC().Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return t.addBusinessDays(day, 1, false)
+}
+
+// the day n business days after day, Saturdays counting when the carrier delivers on them
+func (t *RateTable) addBusinessDays(day time.Time, n int, saturdays bool) time.Time {
+	for n > 0 {
+		day = day.AddDate(0, 0, 1)
+		if t.isBusinessDay(day, saturdays) {
+			n--
+		}
+	}
+	return day
+}
+
+// whether a day is neither a holiday nor in the weekend
+func (t *RateTable) isBusinessDay(day time.Time, saturdays bool) bool {
+	switch day.Weekday() {
+	case time.Sunday:
+		return false
+	case time.Saturday:
+		if !saturdays {
+			return false
+		}
+	}
+	return !t.holidays[day.Format(dateLayout)]
+}
+
+// price in cents the carrier charges for a parcel to a zone, false if it doesn't serve it
+func (c *Carrier) price(zone string, p Parcel) (int64, bool) {
+	rates, ok := c.Zones[zone]
+	if !ok {
+		return 0, false
+	}
+	grams := c.billableGrams(p)
+	for _, b := range rates.Brackets {
+		if grams <= b.MaxGrams {
+			return b.PriceCents, true
+		}
+	}
+	if rates.ExtraPerKgCents == 0 {
+		return 0, false
+	}
+	last := rates.Brackets[len(rates.Brackets)-1]
+	extraKg := (grams - last.MaxGrams + 999) / 1000
+	return last.PriceCents + extraKg*rates.ExtraPerKgCents, true
+}
+
+// the greater of the actual and the dimensional weight: a bulky, light parcel
+// pays for the room it takes
+func (c *Carrier) billableGrams(p Parcel) int64 {
+	if c.DimDivisor <= 0 {
+		return p.Grams
+	}
+	// mm³ / 1000 = cm³, cm³ / divisor = kg, kg * 1000 = g
+	return max(p.Grams, p.VolumeMm3/c.DimDivisor)
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"money"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Two carriers: ACME bills dimensional weight and charges extra kgs in every
+// zone, POST bills actual weight and serves nothing above its last bracket
+const testRates = `{
+	"default_zone": "international",
+	"zones": [
+		{"name": "domestic", "countries": ["US", "United States"], "free_over_cents": 5000},
+		{"name": "europe", "countries": ["DE", "Germany", " fr "]},
+		{"name": "international", "countries": []}
+	],
+	"carriers": [
+		{
+			"name": "ACME",
+			"dim_divisor": 5000,
+			"services": [
+				{"level": "standard", "name": "Ground", "price_percent": 100, "transit_days": {"domestic": [2, 4], "europe": [5, 8]}},
+				{"level": "express", "name": "Air", "price_percent": 250, "transit_days": {"domestic": [1, 1], "europe": [2, 3]}}
+			],
+			"zones": {
+				"domestic": {"brackets": [{"max_grams": 500, "price_cents": 500}, {"max_grams": 2000, "price_cents": 900}], "extra_per_kg_cents": 200},
+				"europe": {"brackets": [{"max_grams": 1000, "price_cents": 2000}], "extra_per_kg_cents": 700}
+			}
+		},
+		{
+			"name": "POST",
+			"services": [
+				{"level": "standard", "name": "Parcel", "price_percent": 100, "transit_days": {"domestic": [3, 6], "international": [10, 20]}}
+			],
+			"zones": {
+				"domestic": {"brackets": [{"max_grams": 1000, "price_cents": 450}, {"max_grams": 3000, "price_cents": 1100}]},
+				"international": {"brackets": [{"max_grams": 2000, "price_cents": 3000}]}
+			}
+		}
+	]
+}`
+
+func loadTestRates(t *testing.T, data string) *RateTable {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	table, err := LoadRateTable(path)
+	if err != nil {
+		t.Fatalf("LoadRateTable: %v", err)
+	}
+	return table
+}
+
+func carrier(t *testing.T, table *RateTable, name string) *Carrier {
+	t.Helper()
+	for _, c := range table.Carriers {
+		if c.Name == name {
+			return c
+		}
+	}
+	t.Fatalf("no carrier %s", name)
+	return nil
+}
+
+func TestCarrierPriceAtBracketEdges(t *testing.T) {
+	table := loadTestRates(t, testRates)
+	tests := []struct {
+		carrier string
+		zone    string
+		parcel  Parcel
+		cents   int64
+		served  bool
+	}{
+		// first bracket, down to an empty parcel
+		{"ACME", "domestic", Parcel{Grams: 0}, 500, true},
+		{"ACME", "domestic", Parcel{Grams: 1}, 500, true},
+		{"ACME", "domestic", Parcel{Grams: 500}, 500, true},
+		// next bracket from one gram above
+		{"ACME", "domestic", Parcel{Grams: 501}, 900, true},
+		{"ACME", "domestic", Parcel{Grams: 2000}, 900, true},
+		// above the last bracket, each started kg is charged
+		{"ACME", "domestic", Parcel{Grams: 2001}, 1100, true},
+		{"ACME", "domestic", Parcel{Grams: 3000}, 1100, true},
+		{"ACME", "domestic", Parcel{Grams: 3001}, 1300, true},
+		{"ACME", "domestic", Parcel{Grams: 12000}, 2900, true},
+		// a single bracket
+		{"ACME", "europe", Parcel{Grams: 1000}, 2000, true},
+		{"ACME", "europe", Parcel{Grams: 1001}, 2700, true},
+		// dimensional weight: 2000000 mm³ / 5000 = 400 g, 2505000 mm³ = 501 g
+		{"ACME", "domestic", Parcel{Grams: 100, VolumeMm3: 2000000}, 500, true},
+		{"ACME", "domestic", Parcel{Grams: 100, VolumeMm3: 2500000}, 500, true},
+		{"ACME", "domestic", Parcel{Grams: 100, VolumeMm3: 2505000}, 900, true},
+		// the actual weight counts when it is the greater
+		{"ACME", "domestic", Parcel{Grams: 501, VolumeMm3: 2500000}, 900, true},
+		// zones without rates aren't served
+		{"ACME", "international", Parcel{Grams: 1}, 0, false},
+
+		// POST bills the actual weight only
+		{"POST", "domestic", Parcel{Grams: 1000, VolumeMm3: 1e9}, 450, true},
+		{"POST", "domestic", Parcel{Grams: 1001}, 1100, true},
+		{"POST", "domestic", Parcel{Grams: 3000}, 1100, true},
+		// and nothing above its last bracket
+		{"POST", "domestic", Parcel{Grams: 3001}, 0, false},
+		{"POST", "international", Parcel{Grams: 2000}, 3000, true},
+		{"POST", "international", Parcel{Grams: 2001}, 0, false},
+		{"POST", "europe", Parcel{Grams: 1}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%dg/%dmm3", tt.carrier, tt.zone, tt.parcel.Grams, tt.parcel.VolumeMm3), func(t *testing.T) {
+			cents, served := carrier(t, table, tt.carrier).price(tt.zone, tt.parcel)
+			if cents != tt.cents || served != tt.served {
+				t.Fatalf("price = %d, %v, want %d, %v", cents, served, tt.cents, tt.served)
+			}
+		})
+	}
+}
+
+// every bracket edge of every carrier and zone of the shipped rate table
+func TestShippedRatesAtBracketEdges(t *testing.T) {
+	table, err := LoadRateTable("../data/rates.json")
+	if err != nil {
+		t.Fatalf("LoadRateTable: %v", err)
+	}
+	for _, c := range table.Carriers {
+		for zone, rates := range c.Zones {
+			type edge struct {
+				grams  int64
+				cents  int64
+				served bool
+			}
+			edges := []edge{{1, rates.Brackets[0].PriceCents, true}}
+			for i, b := range rates.Brackets {
+				edges = append(edges, edge{b.MaxGrams, b.PriceCents, true})
+				if i+1 < len(rates.Brackets) {
+					edges = append(edges, edge{b.MaxGrams + 1, rates.Brackets[i+1].PriceCents, true})
+				}
+			}
+			last := rates.Brackets[len(rates.Brackets)-1]
+			extra := rates.ExtraPerKgCents
+			served := extra > 0
+			edges = append(edges,
+				edge{last.MaxGrams + 1, last.PriceCents + extra, served},
+				edge{last.MaxGrams + 1000, last.PriceCents + extra, served},
+				edge{last.MaxGrams + 1001, last.PriceCents + 2*extra, served},
+			)
+			for _, e := range edges {
+				if !e.served {
+					e.cents = 0
+				}
+				cents, ok := c.price(zone, Parcel{Grams: e.grams})
+				if cents != e.cents || ok != e.served {
+					t.Errorf("%s to %s, %d g = %d, %v, want %d, %v", c.Name, zone, e.grams, cents, ok, e.cents, e.served)
+				}
+			}
+		}
+	}
+}
+
+func TestZoneOf(t *testing.T) {
+	table := loadTestRates(t, testRates)
+	tests := []struct {
+		country string
+		zone    string
+	}{
+		{"US", "domestic"},
+		{"us", "domestic"},
+		{" United States ", "domestic"},
+		{"UNITED STATES", "domestic"},
+		{"DE", "europe"},
+		{"germany", "europe"},
+		// listed with spaces around it
+		{"FR", "europe"},
+		// unlisted countries fall in the default zone
+		{"USA", "international"},
+		{"JP", "international"},
+		{"", "international"},
+	}
+	for _, tt := range tests {
+		if got := table.ZoneOf(tt.country).Name; got != tt.zone {
+			t.Errorf("ZoneOf(%q) = %s, want %s", tt.country, got, tt.zone)
+		}
+	}
+}
+
+func TestOptionsByZone(t *testing.T) {
+	table := loadTestRates(t, testRates)
+	// a Wednesday, so that delivery windows don't matter here
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cents := func(units int64, nanos int32) money.Money {
+		return money.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+	}
+	tests := []struct {
+		name    string
+		country string
+		grams   int64
+		value   money.Money
+		want    []string
+	}{
+		{"domestic, cheapest first", "US", 500, cents(10, 0), []string{"POST standard 450", "ACME standard 500", "ACME express 1250"}},
+		{"domestic, one gram above a bracket", "US", 1001, cents(10, 0), []string{"ACME standard 900", "POST standard 1100", "ACME express 2250"}},
+		{"domestic, above POST's last bracket", "US", 3001, cents(10, 0), []string{"ACME standard 1300", "ACME express 3250"}},
+		{"one cent below free shipping", "US", 500, cents(49, 990000000), []string{"POST standard 450", "ACME standard 500", "ACME express 1250"}},
+		{"at free shipping, standard only", "US", 500, cents(50, 0), []string{"ACME standard 0", "POST standard 0", "ACME express 1250"}},
+		{"europe", "Germany", 1001, cents(1000, 0), []string{"ACME standard 2700", "ACME express 6750"}},
+		{"default zone", "JP", 2000, cents(10, 0), []string{"POST standard 3000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, err := table.Options(tt.country, Parcel{Grams: tt.grams}, tt.value, now)
+			if err != nil {
+				t.Fatalf("Options: %v", err)
+			}
+			var got []string
+			for _, o := range options {
+				got = append(got, fmt.Sprintf("%s %s %d", o.Carrier, o.Level, o.Cents))
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("Options = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := table.Options("JP", Parcel{Grams: 2001}, cents(10, 0), now); !errors.Is(err, ErrNoRate) {
+		t.Fatalf("Options of a parcel no carrier takes = %v, want ErrNoRate", err)
+	}
+}
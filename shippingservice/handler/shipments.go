@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"shippingservice/shipmentstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "shippingservice/proto"
+)
+
+// Returned for a status change the shipment lifecycle doesn't allow
+var ErrInvalidTransition = errors.New("invalid shipment status change")
+
+// leaves a canceled shipment as it is
+var errAlreadyCanceled = errors.New("shipment already canceled")
+
+// Statuses a shipment may move to from each status; DELIVERED and CANCELED are final
+var nextStatuses = map[pb.ShipmentStatus][]pb.ShipmentStatus{
+	pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED: {
+		pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT,
+		pb.ShipmentStatus_SHIPMENT_STATUS_EXCEPTION,
+		pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED,
+	},
+	pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT: {
+		pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+		pb.ShipmentStatus_SHIPMENT_STATUS_EXCEPTION,
+	},
+	pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY: {
+		pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED,
+		pb.ShipmentStatus_SHIPMENT_STATUS_EXCEPTION,
+	},
+	pb.ShipmentStatus_SHIPMENT_STATUS_EXCEPTION: {
+		pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT,
+	},
+}
+
+// Get Shipment
+func (s *ShippingService) GetShipment(ctx context.Context, in *pb.GetShipmentRequest) (out *pb.Shipment, e error) {
+	shipment, err := s.Shipments.Get(ctx, in.TrackingId)
+	if errors.Is(err, shipmentstore.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", in.TrackingId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get shipment: %+v", err)
+	}
+	return shipment, nil
+}
+
+// Update Shipment Status, as reported by the carrier
+func (s *ShippingService) UpdateShipmentStatus(ctx context.Context, in *pb.UpdateShipmentStatusRequest) (out *pb.Shipment, e error) {
+	logger.Printf("[UpdateShipmentStatus] %s to %s", in.TrackingId, in.Status)
+	if in.Status == pb.ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "status is required")
+	}
+	now := time.Now()
+	shipment, err := s.Shipments.Update(ctx, in.TrackingId, func(shipment *pb.Shipment) error {
+		return transition(shipment, in.Status, now, in.Note)
+	})
+	switch {
+	case errors.Is(err, shipmentstore.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", in.TrackingId)
+	case errors.Is(err, ErrInvalidTransition):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Failed to update shipment: %+v", err)
+	}
+	return shipment, nil
+}
+
+// Cancel Shipment of a failed order, before the carrier picks it up; canceling
+// it again is a no-op
+func (s *ShippingService) CancelShipment(ctx context.Context, in *pb.CancelShipmentRequest) (out *pb.Shipment, e error) {
+	logger.Printf("[CancelShipment] %s", in.TrackingId)
+	now := time.Now()
+	shipment, err := s.Shipments.Update(ctx, in.TrackingId, func(shipment *pb.Shipment) error {
+		if shipment.Status == pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED {
+			return errAlreadyCanceled
+		}
+		return transition(shipment, pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED, now, in.Note)
+	})
+	switch {
+	case errors.Is(err, errAlreadyCanceled):
+		return s.GetShipment(ctx, &pb.GetShipmentRequest{TrackingId: in.TrackingId})
+	case errors.Is(err, shipmentstore.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", in.TrackingId)
+	case errors.Is(err, ErrInvalidTransition):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Failed to cancel shipment: %+v", err)
+	}
+	return shipment, nil
+}
+
+// move a shipment to a status at a time and record the change in its events
+func transition(shipment *pb.Shipment, to pb.ShipmentStatus, at time.Time, note string) error {
+	if !slices.Contains(nextStatuses[shipment.Status], to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, shipment.Status, to)
+	}
+	shipment.Status = to
+	shipment.UpdatedAt = timestamppb.New(at)
+	shipment.Events = append(shipment.Events, &pb.ShipmentEvent{
+		Status: to,
+		Time:   timestamppb.New(at),
+		Note:   note,
+	})
+	return nil
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"shippingservice/shipmentstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "shippingservice/proto"
+)
+
+const (
+	labelCreated   = pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED
+	inTransit      = pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT
+	outForDelivery = pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY
+	delivered      = pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED
+	exception      = pb.ShipmentStatus_SHIPMENT_STATUS_EXCEPTION
+	canceled       = pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED
+)
+
+// Monday 6 May 2024, 09:00 UTC
+var shipDay = time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC)
+
+// a shipment labeled at 09:00 on the ship day, picked up that day and due two days later
+func testShipment(id string) *pb.Shipment {
+	dispatch := shipDay.Truncate(24 * time.Hour)
+	return &pb.Shipment{
+		TrackingId:       id,
+		Status:           labelCreated,
+		CreatedAt:        timestamppb.New(shipDay),
+		UpdatedAt:        timestamppb.New(shipDay),
+		DispatchAt:       timestamppb.New(dispatch),
+		EarliestDelivery: timestamppb.New(dispatch.AddDate(0, 0, 2)),
+		LatestDelivery:   timestamppb.New(dispatch.AddDate(0, 0, 4)),
+		Events:           []*pb.ShipmentEvent{{Status: labelCreated, Time: timestamppb.New(shipDay)}},
+	}
+}
+
+// a file shipment store holding the given shipments
+func newTestShipments(t *testing.T, shipments ...*pb.Shipment) shipmentstore.ShipmentStore {
+	t.Helper()
+	store, err := shipmentstore.NewFileShipmentStore(filepath.Join(t.TempDir(), "shipments.log"))
+	if err != nil {
+		t.Fatalf("NewFileShipmentStore: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	for _, s := range shipments {
+		if err := store.Create(context.Background(), s); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	return store
+}
+
+// statuses of the events of a shipment
+func eventStatuses(shipment *pb.Shipment) []pb.ShipmentStatus {
+	var out []pb.ShipmentStatus
+	for _, e := range shipment.GetEvents() {
+		out = append(out, e.GetStatus())
+	}
+	return out
+}
+
+func TestTransitions(t *testing.T) {
+	all := []pb.ShipmentStatus{labelCreated, inTransit, outForDelivery, delivered, exception, canceled}
+	allowed := map[pb.ShipmentStatus][]pb.ShipmentStatus{
+		labelCreated:   {inTransit, exception, canceled},
+		inTransit:      {outForDelivery, exception},
+		outForDelivery: {delivered, exception},
+		exception:      {inTransit},
+		delivered:      nil,
+		canceled:       nil,
+	}
+	at := shipDay.Add(time.Hour)
+	for _, from := range all {
+		for _, to := range all {
+			shipment := testShipment("T1")
+			shipment.Status = from
+			err := transition(shipment, to, at, "note")
+			want := slices.Contains(allowed[from], to)
+			if (err == nil) != want {
+				t.Errorf("transition from %s to %s = %v, want allowed %v", from, to, err, want)
+				continue
+			}
+			if !want {
+				if shipment.Status != from || len(shipment.Events) != 1 {
+					t.Errorf("rejected transition from %s to %s changed the shipment", from, to)
+				}
+				continue
+			}
+			last := shipment.Events[len(shipment.Events)-1]
+			if shipment.Status != to || last.Status != to || last.Note != "note" || !last.Time.AsTime().Equal(at) || !shipment.UpdatedAt.AsTime().Equal(at) {
+				t.Errorf("transition from %s to %s left %v", from, to, shipment)
+			}
+		}
+	}
+}
+
+func TestUpdateShipmentStatus(t *testing.T) {
+	ctx := context.Background()
+	s := &ShippingService{Shipments: newTestShipments(t, testShipment("T1"))}
+	update := func(to pb.ShipmentStatus) codes.Code {
+		_, err := s.UpdateShipmentStatus(ctx, &pb.UpdateShipmentStatusRequest{TrackingId: "T1", Status: to})
+		return status.Code(err)
+	}
+	steps := []struct {
+		to   pb.ShipmentStatus
+		code codes.Code
+	}{
+		{outForDelivery, codes.FailedPrecondition},
+		{pb.ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED, codes.InvalidArgument},
+		{inTransit, codes.OK},
+		{exception, codes.OK},
+		{outForDelivery, codes.FailedPrecondition},
+		{inTransit, codes.OK},
+		{outForDelivery, codes.OK},
+		{delivered, codes.OK},
+		{exception, codes.FailedPrecondition},
+	}
+	for i, step := range steps {
+		if code := update(step.to); code != step.code {
+			t.Fatalf("step %d, update to %s = %s, want %s", i, step.to, code, step.code)
+		}
+	}
+	shipment, _ := s.GetShipment(ctx, &pb.GetShipmentRequest{TrackingId: "T1"})
+	want := []pb.ShipmentStatus{labelCreated, inTransit, exception, inTransit, outForDelivery, delivered}
+	if got := eventStatuses(shipment); !slices.Equal(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	if _, err := s.UpdateShipmentStatus(ctx, &pb.UpdateShipmentStatusRequest{TrackingId: "T9", Status: inTransit}); status.Code(err) != codes.NotFound {
+		t.Fatalf("update of an unknown shipment = %v, want NotFound", err)
+	}
+}
+
+func TestCancelShipment(t *testing.T) {
+	tests := []struct {
+		name   string
+		status pb.ShipmentStatus
+		code   codes.Code
+		// status afterwards
+		want pb.ShipmentStatus
+	}{
+		{"label created", labelCreated, codes.OK, canceled},
+		{"already canceled", canceled, codes.OK, canceled},
+		{"picked up", inTransit, codes.FailedPrecondition, inTransit},
+		{"out for delivery", outForDelivery, codes.FailedPrecondition, outForDelivery},
+		{"delivered", delivered, codes.FailedPrecondition, delivered},
+		{"exception", exception, codes.FailedPrecondition, exception},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			shipment := testShipment("T1")
+			shipment.Status = tt.status
+			s := &ShippingService{Shipments: newTestShipments(t, shipment)}
+			_, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{TrackingId: "T1", Note: "order failed"})
+			if status.Code(err) != tt.code {
+				t.Fatalf("CancelShipment = %v, want %s", err, tt.code)
+			}
+			got, _ := s.GetShipment(ctx, &pb.GetShipmentRequest{TrackingId: "T1"})
+			if got.Status != tt.want {
+				t.Fatalf("status after CancelShipment = %s, want %s", got.Status, tt.want)
+			}
+			// canceling doesn't add an event to a canceled shipment
+			wantEvents := 1
+			if tt.status == labelCreated {
+				wantEvents = 2
+			}
+			if len(got.Events) != wantEvents {
+				t.Fatalf("%d events after CancelShipment, want %d", len(got.Events), wantEvents)
+			}
+		})
+	}
+}
+
+func TestCancelShipmentTwice(t *testing.T) {
+	ctx := context.Background()
+	s := &ShippingService{Shipments: newTestShipments(t, testShipment("T1"))}
+	first, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{TrackingId: "T1", Note: "order failed"})
+	if err != nil {
+		t.Fatalf("CancelShipment: %v", err)
+	}
+	again, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{TrackingId: "T1"})
+	if err != nil || len(again.Events) != len(first.Events) || again.Events[1].Note != "order failed" {
+		t.Fatalf("second CancelShipment = %v, %v, want the canceled shipment unchanged", again, err)
+	}
+	if _, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{TrackingId: "T9"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("CancelShipment of an unknown shipment = %v, want NotFound", err)
+	}
+}
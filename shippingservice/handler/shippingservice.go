@@ -3,12 +3,31 @@ package handler
 import (
 	"bytes"
 	"context"
-	"fmt"
+	"errors"
 	"log"
+	"money"
+	"time"
+
+	"shippingservice/shipmentstore"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	pb "shippingservice/proto"
 )
 
-type ShippingService struct{}
+// Weight counted for a product whose weight isn't known, so that it isn't shipped for free
+const defaultItemGrams = 500
+
+// Tracking IDs tried before giving up on finding a free one
+const trackingIdAttempts = 5
+
+type ShippingService struct {
+	Rates                 *RateTable
+	Shipments             shipmentstore.ShipmentStore
+	ProductCatalogService pb.ProductCatalogServiceClient
+}
 
 // Log
 var (
@@ -20,15 +39,33 @@ func (s *ShippingService) GetQuote(ctx context.Context, in *pb.GetQuoteRequest)
 	logger.Print("[GetQuote] Request received")
 	defer logger.Print("[GetQuote] Request completed")
 
-	// 1. Generate quote based on the count of items
+	// 1. Weigh and value the items
 	out = new(pb.GetQuoteResponse)
-	quote := CreateQuoteFromCount(0)
+	if len(in.Items) == 0 {
+		out.CostUsd = Quote{}.Money()
+		return out, nil
+	}
+	parcel, value, err := s.pack(ctx, in.Items)
+	if err != nil {
+		return nil, err
+	}
 
-	// 2. Generate response
-	out.CostUsd = &pb.Money{
-		CurrencyCode: "USD",
-		Units:        int64(quote.Dollars),
-		Nanos:        int32(quote.Cents * 10000000),
+	// 2. Generate the options based on weight, size and destination
+	options, err := s.Rates.Options(destination(in.Address), parcel, value, time.Now())
+	if errors.Is(err, ErrNoRate) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to quote shipping: %v", err)
+	}
+	cheapest := options[0]
+	logger.Printf("Quote %d options from %s by %s to %s for %d g", len(options), cheapest, cheapest.ID(), cheapest.Zone, parcel.Grams)
+
+	// 3. Generate response
+	out.CostUsd = cheapest.Money()
+	out.Carrier = cheapest.Carrier
+	for _, q := range options {
+		out.Options = append(out.Options, q.Option())
 	}
 	return out, nil
 }
@@ -36,12 +73,118 @@ func (s *ShippingService) GetQuote(ctx context.Context, in *pb.GetQuoteRequest)
 func (s *ShippingService) ShipOrder(ctx context.Context, in *pb.ShipOrderRequest) (out *pb.ShipOrderResponse, e error) {
 	logger.Print("[ShipOrder] Request received")
 	defer logger.Print("[ShipOrder] Request completed")
-	// 1. Create tracking ID
-	out = new(pb.ShipOrderResponse)
-	baseAddress := fmt.Sprintf("%s, %s, %s", in.Address.StreetAddress, in.Address.City, in.Address.State)
-	id := CreateTrackingId(baseAddress)
 
-	// 2. Generate response
-	out.TrackingId = id
+	// 1. Check the address
+	check := validateAddress(in.Address)
+	if !check.Valid {
+		return nil, status.Errorf(codes.InvalidArgument, "undeliverable address: %s", issuesText(check.Issues))
+	}
+	address := check.Normalized
+
+	// 2. Pick the chosen option, the cheapest when none is given
+	now := time.Now()
+	country := address.GetCountryCode()
+	id := in.GetShippingOptionId()
+	if id == "" {
+		parcel, value, err := s.pack(ctx, in.Items)
+		if err != nil {
+			return nil, err
+		}
+		options, err := s.Rates.Options(country, parcel, value, now)
+		if errors.Is(err, ErrNoRate) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to quote shipping: %v", err)
+		}
+		id = options[0].ID()
+	}
+	option, err := s.Rates.Option(id, country, now)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	logger.Printf("Ship with %s %s", option.Carrier, option.Name)
+
+	// 3. Record the shipment under a new tracking ID
+	shipment := &pb.Shipment{
+		Status:           pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED,
+		Carrier:          option.Carrier,
+		ShippingOptionId: id,
+		Address:          address,
+		Items:            in.Items,
+		CreatedAt:        timestamppb.New(now),
+		UpdatedAt:        timestamppb.New(now),
+		DispatchAt:       timestamppb.New(s.Rates.DispatchDay(now)),
+		EarliestDelivery: timestamppb.New(option.Earliest),
+		LatestDelivery:   timestamppb.New(option.Latest),
+		Events: []*pb.ShipmentEvent{{
+			Status: pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED,
+			Time:   timestamppb.New(now),
+		}},
+	}
+	for attempt := 1; ; attempt++ {
+		shipment.TrackingId = CreateTrackingId()
+		err = s.Shipments.Create(ctx, shipment)
+		if !errors.Is(err, shipmentstore.ErrExists) || attempt == trackingIdAttempts {
+			break
+		}
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to record shipment: %+v", err)
+	}
+
+	// 4. Generate response
+	out = new(pb.ShipOrderResponse)
+	out.TrackingId = shipment.TrackingId
 	return out, nil
 }
+
+// destination country of an address, from the legacy field for older clients
+func destination(a *pb.Address) string {
+	if c := a.GetCountryCode(); c != "" {
+		return c
+	}
+	return a.GetCountry()
+}
+
+// pack the items in one parcel and add up their USD value
+func (s *ShippingService) pack(ctx context.Context, items []*pb.CartItem) (Parcel, money.Money, error) {
+	var parcel Parcel
+	value := money.Zero("USD")
+	for _, item := range items {
+		if item.GetQuantity() <= 0 {
+			return parcel, value, status.Errorf(codes.InvalidArgument, "Invalid quantity %d for product %s", item.GetQuantity(), item.GetProductId())
+		}
+		p, err := s.ProductCatalogService.GetProduct(ctx, &pb.GetProductRequest{Id: item.GetProductId()})
+		if err != nil {
+			return parcel, value, status.Errorf(codes.Unavailable, "Failed to get product %s: %v", item.GetProductId(), err)
+		}
+		qty := int64(item.GetQuantity())
+		grams := int64(p.GetWeightGrams())
+		if grams == 0 {
+			grams = defaultItemGrams
+		}
+		parcel.Grams += grams * qty
+		d := p.GetDimensions()
+		parcel.VolumeMm3 += int64(d.GetLengthMm()) * int64(d.GetWidthMm()) * int64(d.GetHeightMm()) * qty
+
+		line, err := money.Multiply(money.From(itemPrice(p, item.GetVariantSku())), qty)
+		if err == nil {
+			value, err = money.Sum(value, line)
+		}
+		if err != nil {
+			return parcel, value, status.Errorf(codes.Internal, "Failed to value product %s: %v", item.GetProductId(), err)
+		}
+	}
+	return parcel, value, nil
+}
+
+// USD price of a product, or of its variant when it has one of its own
+func itemPrice(p *pb.Product, sku string) *pb.Money {
+	for _, v := range p.GetVariants() {
+		if v.GetSku() == sku && v.GetPriceUsd() != nil {
+			return v.GetPriceUsd()
+		}
+	}
+	return p.GetPriceUsd()
+}
@@ -3,20 +3,25 @@ package handler
 import (
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 )
 
-var localRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+// a rand.Rand isn't safe for concurrent use, so every use of localRand holds randMu
+var (
+	randMu    sync.Mutex
+	localRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
 
-// CreateTrackingId generates a tracking ID for simulation
-func CreateTrackingId(salt string) string {
-	return fmt.Sprintf("%c%c-%d%s-%d%s",
+// CreateTrackingId generates a random tracking ID for simulation, e.g. "QX-48213-90561274"
+func CreateTrackingId() string {
+	randMu.Lock()
+	defer randMu.Unlock()
+	return fmt.Sprintf("%c%c-%s-%s",
 		getRandomLetterCode(),
 		getRandomLetterCode(),
-		len(salt),
-		getRandomNumber(3),
-		len(salt)/2,
-		getRandomNumber(7),
+		getRandomNumber(5),
+		getRandomNumber(8),
 	)
 }
 
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	handler "shippingservice/handler"
 	pb "shippingservice/proto"
+	"shippingservice/shipmentstore"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"google.golang.org/grpc"
@@ -14,6 +17,44 @@ import (
 const PORT = 50018
 const ADDRESS = "127.0.0.1"
 
+// Shipping zones and carrier rates
+const RATES_PATH = "data/rates.json"
+
+// Shipment tracking log
+const SHIPMENT_STORE_PATH = "data/shipments.log"
+
+// How often the simulated carriers move shipments along
+const CARRIER_CLOCK_INTERVAL = time.Minute
+
+func GetGrpcConn(consulClient *api.Client, serviceName string, serviceTag string) *grpc.ClientConn {
+	service, _, err := consulClient.Health().Service(serviceName, serviceTag, true, nil)
+	if err != nil {
+		fmt.Println("Error retrieving healthy service:", err)
+		return nil
+	}
+	if len(service) == 0 {
+		fmt.Println("No healthy services found")
+		return nil
+	}
+	s := service[0].Service
+	address := s.Address + ":" + strconv.Itoa(s.Port)
+	fmt.Printf("Service name: %v\n", serviceName)
+	fmt.Printf("Address: %s\n", address)
+
+	// Connect to the gRPC service with secure credentials
+	// creds := credentials.NewClientTLSFromCert(nil, "")
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+	}
+	grpcConn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		fmt.Println("Error connecting to gRPC service:", err)
+		return nil
+	}
+
+	return grpcConn
+}
+
 func main() {
 	ipport := ADDRESS + ":" + strconv.Itoa(PORT)
 	// -------------Register on consul---------------
@@ -42,12 +83,45 @@ func main() {
 		return
 	}
 
+	// load the rate table
+	rates, err_rates := handler.LoadRateTable(RATES_PATH)
+	if err_rates != nil {
+		fmt.Println("rate table init error:", err_rates)
+		return
+	}
+
+	// init shipment store
+	shipments, err_shipments := shipmentstore.NewFileShipmentStore(SHIPMENT_STORE_PATH)
+	if err_shipments != nil {
+		fmt.Println("shipment store init error:", err_shipments)
+		return
+	}
+	defer shipments.Close()
+
+	// start simulated carrier clock
+	clock := &handler.CarrierClock{
+		Shipments: shipments,
+		Interval:  CARRIER_CLOCK_INTERVAL,
+		OnTick: func(advanced int, err error) {
+			if err != nil {
+				fmt.Println("carrier clock error:", err)
+			} else if advanced > 0 {
+				fmt.Printf("Advanced %d shipments\n", advanced)
+			}
+		},
+	}
+	go clock.Run(context.Background())
+
 	//-----------------------grpc code--------------------------------
 	// init grpc server
 	grpcServer := grpc.NewServer()
 
-	// register grpc service
-	pb.RegisterShippingServiceServer(grpcServer, new(handler.ShippingService))
+	// register grpc service; products are weighed with the catalog
+	pb.RegisterShippingServiceServer(grpcServer, &handler.ShippingService{
+		Rates:                 rates,
+		Shipments:             shipments,
+		ProductCatalogService: pb.NewProductCatalogServiceClient(GetGrpcConn(consulClient, "productcatalogservice", "productcatalogservice")),
+	})
 
 	// start grpc listen
 	listen, err := net.Listen("tcp", ipport)
@@ -0,0 +1,140 @@
+package shipmentstore
+
+import (
+	"context"
+	"fmt"
+	"recordlog"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "shippingservice/proto"
+)
+
+// Shipments are kept in memory and every change is appended to a record log
+// and fsynced before it is applied, so tracking survives a restart or a
+// crash. The last record of a shipment wins.
+type fileShipmentStore struct {
+	sync.RWMutex
+	log       *recordlog.Log[*pb.Shipment]
+	shipments map[string]*pb.Shipment
+	// tracking IDs, oldest first
+	ids []string
+}
+
+// Shipments are logged as protojson
+var shipmentCodec = recordlog.Codec[*pb.Shipment]{
+	Marshal: func(shipment *pb.Shipment) ([]byte, error) {
+		return protojson.Marshal(shipment)
+	},
+	Unmarshal: func(data []byte) (*pb.Shipment, error) {
+		shipment := new(pb.Shipment)
+		return shipment, protojson.Unmarshal(data, shipment)
+	},
+}
+
+// Instantiate a ShipmentStore persisted in the log file at path
+func NewFileShipmentStore(path string) (ShipmentStore, error) {
+	s := &fileShipmentStore{shipments: make(map[string]*pb.Shipment)}
+	l, err := recordlog.Open(path, recordlog.Config[*pb.Shipment]{
+		Name:     "shipment log",
+		Codec:    shipmentCodec,
+		Apply:    s.apply,
+		Snapshot: s.snapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log = l
+	return s, nil
+}
+
+// Create Shipment
+func (s *fileShipmentStore) Create(ctx context.Context, shipment *pb.Shipment) error {
+	if shipment.GetTrackingId() == "" {
+		return fmt.Errorf("shipment has no tracking id")
+	}
+	shipment = proto.Clone(shipment).(*pb.Shipment)
+
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.shipments[shipment.GetTrackingId()]; ok {
+		return ErrExists
+	}
+	return s.log.Append(shipment)
+}
+
+// Get Shipment
+func (s *fileShipmentStore) Get(ctx context.Context, trackingID string) (*pb.Shipment, error) {
+	s.RLock()
+	defer s.RUnlock()
+	shipment, ok := s.shipments[trackingID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(shipment).(*pb.Shipment), nil
+}
+
+// Update Shipment
+func (s *fileShipmentStore) Update(ctx context.Context, trackingID string, fn func(*pb.Shipment) error) (*pb.Shipment, error) {
+	s.Lock()
+	defer s.Unlock()
+	current, ok := s.shipments[trackingID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	shipment := proto.Clone(current).(*pb.Shipment)
+	if err := fn(shipment); err != nil {
+		return nil, err
+	}
+	if shipment.GetTrackingId() != trackingID {
+		return nil, fmt.Errorf("tracking id of shipment %s can't change", trackingID)
+	}
+	if err := s.log.Append(shipment); err != nil {
+		return nil, err
+	}
+	return proto.Clone(shipment).(*pb.Shipment), nil
+}
+
+// Active Shipments
+func (s *fileShipmentStore) Active(ctx context.Context) ([]string, error) {
+	s.RLock()
+	defer s.RUnlock()
+	var out []string
+	for _, id := range s.ids {
+		switch s.shipments[id].GetStatus() {
+		case pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED, pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED:
+		default:
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// Close the log file
+func (s *fileShipmentStore) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.log.Close()
+}
+
+// apply a recorded shipment to memory; the caller holds the write lock
+func (s *fileShipmentStore) apply(shipment *pb.Shipment) error {
+	if _, ok := s.shipments[shipment.GetTrackingId()]; !ok {
+		s.ids = append(s.ids, shipment.GetTrackingId())
+	}
+	s.shipments[shipment.GetTrackingId()] = shipment
+	return nil
+}
+
+// emit one record per shipment, oldest first, to compact the log; the caller
+// holds the write lock
+func (s *fileShipmentStore) snapshot(emit func(*pb.Shipment) error) error {
+	for _, id := range s.ids {
+		if err := emit(s.shipments[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package shipmentstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	pb "shippingservice/proto"
+)
+
+func openStore(t *testing.T, path string) ShipmentStore {
+	t.Helper()
+	s, err := NewFileShipmentStore(path)
+	if err != nil {
+		t.Fatalf("NewFileShipmentStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func reopen(t *testing.T, s ShipmentStore, path string) ShipmentStore {
+	t.Helper()
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return openStore(t, path)
+}
+
+func create(t *testing.T, s ShipmentStore, id string) {
+	t.Helper()
+	shipment := &pb.Shipment{TrackingId: id, Status: pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED}
+	if err := s.Create(context.Background(), shipment); err != nil {
+		t.Fatalf("Create(%s): %v", id, err)
+	}
+}
+
+func setStatus(t *testing.T, s ShipmentStore, id string, status pb.ShipmentStatus) {
+	t.Helper()
+	_, err := s.Update(context.Background(), id, func(shipment *pb.Shipment) error {
+		shipment.Status = status
+		shipment.Events = append(shipment.Events, &pb.ShipmentEvent{Status: status})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Update(%s): %v", id, err)
+	}
+}
+
+// fill a store with a shipment in each state
+func fill(t *testing.T, s ShipmentStore) {
+	t.Helper()
+	for _, id := range []string{"A", "B", "C", "D"} {
+		create(t, s, id)
+	}
+	setStatus(t, s, "A", pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT)
+	setStatus(t, s, "B", pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED)
+	setStatus(t, s, "C", pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT)
+	setStatus(t, s, "C", pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY)
+	setStatus(t, s, "C", pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED)
+}
+
+// check the store holds what fill recorded
+func check(t *testing.T, s ShipmentStore) {
+	t.Helper()
+	ctx := context.Background()
+	if active, err := s.Active(ctx); err != nil || !slices.Equal(active, []string{"A", "D"}) {
+		t.Fatalf("Active = %v, %v, want [A D]", active, err)
+	}
+	c, err := s.Get(ctx, "C")
+	if err != nil {
+		t.Fatalf("Get(C): %v", err)
+	}
+	if c.Status != pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED || len(c.Events) != 3 {
+		t.Fatalf("Get(C) = %v, want a delivered shipment with 3 events", c)
+	}
+	if _, err := s.Get(ctx, "Z"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get of a missing shipment = %v, want ErrNotFound", err)
+	}
+}
+
+func TestShipments(t *testing.T) {
+	ctx := context.Background()
+	s := openStore(t, filepath.Join(t.TempDir(), "shipments.log"))
+	fill(t, s)
+	check(t, s)
+
+	if err := s.Create(ctx, &pb.Shipment{TrackingId: "A"}); !errors.Is(err, ErrExists) {
+		t.Fatalf("Create of a taken tracking ID = %v, want ErrExists", err)
+	}
+	if err := s.Create(ctx, &pb.Shipment{}); err == nil {
+		t.Fatal("Create of a shipment without a tracking ID succeeded")
+	}
+	if _, err := s.Update(ctx, "Z", func(*pb.Shipment) error { return nil }); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Update of a missing shipment = %v, want ErrNotFound", err)
+	}
+	// failed updates and changed tracking IDs aren't recorded
+	failed := errors.New("failed")
+	if _, err := s.Update(ctx, "A", func(shipment *pb.Shipment) error {
+		shipment.Status = pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED
+		return failed
+	}); !errors.Is(err, failed) {
+		t.Fatalf("failing Update = %v, want its error", err)
+	}
+	if _, err := s.Update(ctx, "A", func(shipment *pb.Shipment) error {
+		shipment.TrackingId = "Z"
+		return nil
+	}); err == nil {
+		t.Fatal("Update changing the tracking ID succeeded")
+	}
+	// shipments handed out are copies
+	a, _ := s.Get(ctx, "A")
+	a.Status = pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED
+	check(t, s)
+}
+
+func TestShipmentsSurviveRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shipments.log")
+	s := openStore(t, path)
+	fill(t, s)
+	s = reopen(t, s, path)
+	check(t, s)
+
+	// and so do updates made after a replay
+	setStatus(t, s, "D", pb.ShipmentStatus_SHIPMENT_STATUS_CANCELED)
+	s = reopen(t, s, path)
+	if active, _ := s.Active(context.Background()); !slices.Equal(active, []string{"A"}) {
+		t.Fatalf("Active after a restart = %v, want [A]", active)
+	}
+}
+
+func TestShipmentsTornTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shipments.log")
+	s := openStore(t, path)
+	fill(t, s)
+	s.Close()
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(`1234abcd {"trackingId":"D","status":"SHIPMENT_STATUS_DEL`); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	s = openStore(t, path)
+	check(t, s)
+	create(t, s, "E")
+	s = reopen(t, s, path)
+	if active, _ := s.Active(context.Background()); !slices.Equal(active, []string{"A", "D", "E"}) {
+		t.Fatalf("Active after a torn tail = %v, want [A D E]", active)
+	}
+}
+
+func TestShipmentsSurviveCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shipments.log")
+	s := openStore(t, path)
+	fill(t, s)
+	if err := s.(*fileShipmentStore).log.Compact(); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	check(t, s)
+	create(t, s, "E")
+	s = reopen(t, s, path)
+	if active, _ := s.Active(context.Background()); !slices.Equal(active, []string{"A", "D", "E"}) {
+		t.Fatalf("Active after compaction = %v, want [A D E]", active)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// one record per shipment
+	if n := bytes.Count(data, []byte("\n")); n != 5 {
+		t.Fatalf("log has %d lines after compaction, want 5", n)
+	}
+}
@@ -0,0 +1,27 @@
+package shipmentstore
+
+import (
+	"context"
+	"errors"
+
+	pb "shippingservice/proto"
+)
+
+// Returned when no shipment has the requested tracking ID
+var ErrNotFound = errors.New("shipment not found")
+
+// Returned when creating a shipment whose tracking ID is taken
+var ErrExists = errors.New("tracking id already in use")
+
+// Shipment interface
+type ShipmentStore interface {
+	// Create records a new shipment
+	Create(ctx context.Context, shipment *pb.Shipment) error
+	Get(ctx context.Context, trackingID string) (*pb.Shipment, error)
+	// Update applies fn to a copy of a shipment and records the result; nothing
+	// is recorded when fn fails, and its error is returned
+	Update(ctx context.Context, trackingID string, fn func(*pb.Shipment) error) (*pb.Shipment, error)
+	// Active returns the tracking IDs of the shipments neither delivered nor canceled
+	Active(ctx context.Context) ([]string, error)
+	Close() error
+}